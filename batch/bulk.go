@@ -16,6 +16,7 @@ import (
 // The zero value is equivalent to NewCaller(0, 0).
 type Caller[Value, Result any] struct {
 	initialDelay   time.Duration
+	maxWait        time.Duration
 	maxConcurrency int
 	mu             sync.Mutex
 	sem            chan struct{}
@@ -35,6 +36,28 @@ func NewCaller[Value, Result any](maxConcurrency int, initialDelay time.Duration
 	}
 }
 
+// NewCallerWithMaxWait is like NewCaller except that it also bounds the
+// time that a call can spend waiting for a free concurrency slot.
+// Once the oldest of the accumulated calls has been waiting for
+// maxWait, the batch is issued regardless of whether a slot is
+// available, so the number of concurrent calls can temporarily exceed
+// maxConcurrency. Such an overflow call does not occupy a slot.
+//
+// The maxWait period starts when the first call of a batch is made,
+// so it includes initialDelay. If initialDelay is at least maxWait,
+// a batch still takes a slot if one is free when the delay ends,
+// and is only issued without one otherwise.
+//
+// If maxWait is non-positive, calls wait indefinitely for a slot,
+// as with NewCaller.
+func NewCallerWithMaxWait[Value, Result any](maxConcurrency int, initialDelay, maxWait time.Duration) *Caller[Value, Result] {
+	return &Caller[Value, Result]{
+		initialDelay:   initialDelay,
+		maxWait:        maxWait,
+		maxConcurrency: maxConcurrency,
+	}
+}
+
 // DoChan is like Do but returns a channel on which the result can be
 // received instead of the result itself.
 func (g *Caller[V, R]) DoChan(v V, call func(vs ...V) ([]R, error)) <-chan Result[R] {
@@ -61,11 +84,23 @@ func (g *Caller[V, R]) DoChan(v V, call func(vs ...V) ([]R, error)) <-chan Resul
 	g.mu.Unlock()
 
 	if isInitial {
-		g.doCall(call)
+		g.doCall(time.Now().Add(g.maxWait), call)
 	}
 	return resultc
 }
 
+// Pending returns the number of calls that have accumulated
+// waiting to be issued as the next batch. Calls in a batch
+// that is currently executing are not included.
+func (g *Caller[V, R]) Pending() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.acc == nil {
+		return 0
+	}
+	return len(g.acc.args)
+}
+
 // Do does the equivalent of:
 //
 //	rs, err := call(v)
@@ -98,17 +133,21 @@ type accumulator[V, R any] struct {
 	results []chan<- Result[R]
 }
 
-func (g *Caller[V, R]) doCall(fn func(...V) ([]R, error)) {
+// doCall issues a call for the current accumulator. The deadline
+// holds the time by which the call must be issued even if no
+// slot is available; it's ignored when g.maxWait is non-positive.
+func (g *Caller[V, R]) doCall(deadline time.Time, fn func(...V) ([]R, error)) {
 	if g.initialDelay > 0 {
 		time.Sleep(g.initialDelay)
 	}
 	// Wait until a call slot is available. Any calls that happen
 	// in the meantime will add their arguments to g.acc
 	// and we'll use them when we make the call.
-	g.sem <- struct{}{}
-	defer func() {
-		<-g.sem
-	}()
+	if g.acquire(deadline) {
+		defer func() {
+			<-g.sem
+		}()
+	}
 	// Remove this call from the group. We're about
 	// to start executing it.
 	g.mu.Lock()
@@ -134,3 +173,29 @@ func (g *Caller[V, R]) doCall(fn func(...V) ([]R, error)) {
 		}
 	}
 }
+
+// acquire waits for a call slot and reports whether one was acquired.
+// If g.maxWait is positive, it gives up waiting at the deadline and
+// returns false.
+func (g *Caller[V, R]) acquire(deadline time.Time) bool {
+	if g.maxWait <= 0 {
+		g.sem <- struct{}{}
+		return true
+	}
+	// Try for a free slot first: if the deadline has already
+	// passed, the select below would choose at random between
+	// the timer and a free slot.
+	select {
+	case g.sem <- struct{}{}:
+		return true
+	default:
+	}
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case g.sem <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
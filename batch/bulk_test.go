@@ -60,3 +60,92 @@ func TestMultipleCalls(t *testing.T) {
 	}
 	log.Printf("total time %v", total)
 }
+
+func TestMaxWait(t *testing.T) {
+	const (
+		maxWait      = 50 * time.Millisecond
+		callDuration = 500 * time.Millisecond
+	)
+	caller := NewCallerWithMaxWait[int, string](1, 0, maxWait)
+	started := make(chan struct{})
+	slow := func(is ...int) ([]string, error) {
+		close(started)
+		time.Sleep(callDuration)
+		return make([]string, len(is)), nil
+	}
+	fast := func(is ...int) ([]string, error) {
+		return make([]string, len(is)), nil
+	}
+	go caller.Do(1, slow)
+	<-started
+
+	// The only slot is now occupied by the slow call, so
+	// the second call should be issued after maxWait
+	// without waiting for the slot to be freed.
+	t0 := time.Now()
+	if _, err := caller.Do(2, fast); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if got, want := time.Since(t0), maxWait+callDuration/2; got > want {
+		t.Errorf("second call took too long; got %v want at most %v", got, want)
+	}
+}
+
+func TestMaxWaitBeforeInitialDelay(t *testing.T) {
+	// The deadline has always passed by the time the initial
+	// delay is over, but the slot is free so it should
+	// always be used.
+	caller := NewCallerWithMaxWait[int, int](1, 2*time.Millisecond, time.Millisecond)
+	for i := 0; i < 20; i++ {
+		r, err := caller.Do(i, func(is ...int) ([]int, error) {
+			if n := len(caller.sem); n != 1 {
+				t.Errorf("call %d made without a slot; %d slots in use", i, n)
+			}
+			return is, nil
+		})
+		if err != nil || r != i {
+			t.Fatalf("unexpected result; got %v, %v want %v, nil", r, err, i)
+		}
+	}
+}
+
+func TestPending(t *testing.T) {
+	caller := NewCaller[int, int](1, 0)
+	if got := caller.Pending(); got != 0 {
+		t.Errorf("unexpected initial pending count; got %d want 0", got)
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	call := func(is ...int) ([]int, error) {
+		if is[0] == 0 {
+			close(started)
+			<-release
+		}
+		return is, nil
+	}
+	go caller.Do(0, call)
+	<-started
+	// The first call is in flight so is not pending.
+	if got := caller.Pending(); got != 0 {
+		t.Errorf("unexpected pending count with call in flight; got %d want 0", got)
+	}
+
+	// The initial call of the next batch blocks until
+	// a slot is available, so start it in its own goroutine
+	// and wait for it to be accumulated.
+	go caller.Do(1, call)
+	for caller.Pending() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	c2, c3 := caller.DoChan(2, call), caller.DoChan(3, call)
+	if got := caller.Pending(); got != 3 {
+		t.Errorf("unexpected pending count; got %d want 3", got)
+	}
+	close(release)
+	if r := <-c2; r.Val != 2 || r.Err != nil {
+		t.Errorf("unexpected result; got %v want %v", r, Result[int]{Val: 2})
+	}
+	if r := <-c3; r.Val != 3 || r.Err != nil {
+		t.Errorf("unexpected result; got %v want %v", r, Result[int]{Val: 3})
+	}
+}
@@ -27,7 +27,9 @@ import (
 	"bytes"
 	"fmt"
 	"hash/maphash"
+	"iter"
 	"math/bits"
+	"sync/atomic"
 
 	"github.com/rogpeppe/generic/gatomic"
 )
@@ -69,6 +71,10 @@ type Map[Key, Value any] struct {
 	readOnly bool
 	hashFunc func(Key) uint64
 	eqFunc   func(Key, Key) bool
+
+	// size holds one more than the cached result of Len
+	// for a read-only Map, or zero if it hasn't been calculated yet.
+	size atomic.Int64
 }
 
 // generation demarcates Map clones. We use a heap-allocated reference
@@ -127,6 +133,14 @@ func NewWithFuncs[Key, Value any](
 	return newMap[Key, Value](root, eqFunc, hashFunc, false)
 }
 
+// Collect returns a new Map holding all the key-value pairs from seq.
+// If a key occurs more than once, the last value wins.
+func Collect[Key Hasher, Value any](seq iter.Seq2[Key, Value]) *Map[Key, Value] {
+	c := New[Key, Value]()
+	c.SetAll(seq)
+	return c
+}
+
 func newMap[Key, Value any](
 	root *iNode[Key, Value],
 	eqFunc func(Key, Key) bool,
@@ -149,7 +163,62 @@ func (c *Map[Key, Value]) Set(key Key, value Value) {
 		key:   key,
 		value: value,
 		hash:  uint32(c.hashFunc(key)),
+	}, nil)
+}
+
+// GetOrSet returns the existing value for the key if present.
+// Otherwise, it sets the value for the key and returns it.
+// The loaded result is true if the value was found, false if set.
+// The lookup and insertion happen as a single atomic operation,
+// so when several goroutines call GetOrSet concurrently for the
+// same absent key, exactly one of them sets the value and the
+// others all observe it.
+func (c *Map[Key, Value]) GetOrSet(key Key, value Value) (actual Value, loaded bool) {
+	c.assertReadWrite()
+	var existing *mapEntry[Key, Value]
+	c.insert(&mapEntry[Key, Value]{
+		key:   key,
+		value: value,
+		hash:  uint32(c.hashFunc(key)),
+	}, func(old *mapEntry[Key, Value]) bool {
+		existing = old
+		return old == nil
 	})
+	if existing != nil {
+		return existing.value, true
+	}
+	return value, false
+}
+
+// SetAll sets all the key-value pairs from seq in the Map,
+// replacing the existing values for any keys that already exist.
+// Other readers may observe some pairs before others.
+func (c *Map[Key, Value]) SetAll(seq iter.Seq2[Key, Value]) {
+	c.SetMany(seq)
+}
+
+// SetMany sets all the key-value pairs from entries in the Map.
+// It has the same effect as calling Set for each pair in turn
+// (other readers may observe some pairs before others) but is
+// more efficient because the root of the trie is only read
+// again when an insertion needs to be retried.
+func (c *Map[Key, Value]) SetMany(entries iter.Seq2[Key, Value]) {
+	c.assertReadWrite()
+	root := c.readRoot()
+	for key, value := range entries {
+		entry := &mapEntry[Key, Value]{
+			key:   key,
+			value: value,
+			hash:  uint32(c.hashFunc(key)),
+		}
+		// Note: if the root has been replaced since we read it
+		// (for example by a concurrent Clone), the generation check
+		// in gcas will cause the insertion to fail, so it's
+		// safe to use the same root until that happens.
+		for !c.iinsert(root, entry, nil, 0, nil, root.gen) {
+			root = c.readRoot()
+		}
+	}
 }
 
 // Get returns the value for the associated key and
@@ -168,7 +237,72 @@ func (c *Map[Key, Value]) Delete(key Key) (Value, bool) {
 	return c.remove(&mapEntry[Key, Value]{
 		key:  key,
 		hash: uint32(c.hashFunc(key)),
-	})
+	}, nil)
+}
+
+// DeleteMany deletes the entries for all the given keys.
+// It has the same effect as calling Delete for each key in turn
+// but is more efficient in the same way as SetMany.
+func (c *Map[Key, Value]) DeleteMany(keys iter.Seq[Key]) {
+	c.assertReadWrite()
+	root := c.readRoot()
+	for key := range keys {
+		entry := &mapEntry[Key, Value]{
+			key:  key,
+			hash: uint32(c.hashFunc(key)),
+		}
+		for {
+			if _, _, ok := c.iremove(root, entry, nil, 0, nil, root.gen); ok {
+				break
+			}
+			root = c.readRoot()
+		}
+	}
+}
+
+// Update atomically updates the value for the given key.
+// It calls f with the current value for the key, if any, and
+// exists reports whether the key is present. If f returns true,
+// the key's value is set to the returned value; otherwise the
+// key is deleted.
+//
+// If another goroutine modifies the entry concurrently, the
+// update is retried, so f may be called several times
+// and should not have side effects.
+func (c *Map[Key, Value]) Update(key Key, f func(old Value, exists bool) (Value, bool)) {
+	c.assertReadWrite()
+	entry := &mapEntry[Key, Value]{
+		key:  key,
+		hash: uint32(c.hashFunc(key)),
+	}
+	for {
+		var existed, keep bool
+		c.insert(entry, func(old *mapEntry[Key, Value]) bool {
+			var oldValue Value
+			if existed = old != nil; existed {
+				oldValue = old.value
+			}
+			entry.value, keep = f(oldValue, existed)
+			return keep
+		})
+		if keep || !existed {
+			return
+		}
+		// f asked for the existing entry to be deleted.
+		deleted := false
+		c.remove(entry, func(old *mapEntry[Key, Value]) bool {
+			// The entry might have changed since it was passed
+			// to f, so check again.
+			_, keep := f(old.value, true)
+			deleted = !keep
+			return deleted
+		})
+		if deleted {
+			return
+		}
+		// Either the key was deleted concurrently or f
+		// wants to keep the new value, so start again.
+	}
 }
 
 // Clone returns a stable, point-in-time clone of the Map. If the Map
@@ -219,20 +353,118 @@ func (c *Map[Key, Value]) Clear() {
 }
 
 // Len returns the number of keys in the Map.
-// This operation is O(n).
+// This operation is O(n), except that for a read-only Map
+// the result is cached, so subsequent calls are O(1).
 func (c *Map[Key, Value]) Len() int {
 	// TODO: The size operation can be optimized further by caching the size
 	// information in main nodes of a read-only Map – this reduces the
 	// amortized complexity of the size operation to O(1) because the size
 	// computation is amortized across the update operations that occurred
 	// since the last clone.
+	if c.readOnly {
+		if size := c.size.Load(); size > 0 {
+			return int(size - 1)
+		}
+	}
 	size := 0
 	for iter := c.Iterator(); iter.Next(); {
 		size++
 	}
+	if c.readOnly {
+		// The contents of a read-only Map never change, and
+		// each clone gets its own Map, so this can never be stale.
+		c.size.Store(int64(size) + 1)
+	}
 	return size
 }
 
+// CountFunc returns the number of entries in the Map for which
+// pred returns true. It operates on a point-in-time snapshot of the
+// Map. This operation is O(n).
+func (c *Map[Key, Value]) CountFunc(pred func(Key, Value) bool) int {
+	n := 0
+	for iter := c.Iterator(); iter.Next(); {
+		if pred(iter.Key(), iter.Value()) {
+			n++
+		}
+	}
+	return n
+}
+
+// All returns an iterator over all the key-value pairs in the Map.
+// Each iteration operates on a point-in-time snapshot of the Map
+// taken when it starts, so it is not affected by concurrent
+// modifications.
+func (c *Map[Key, Value]) All() iter.Seq2[Key, Value] {
+	return func(yield func(Key, Value) bool) {
+		for iter := c.Iterator(); iter.Next(); {
+			if !yield(iter.Key(), iter.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Range calls f for each key-value pair in the Map, stopping
+// if f returns false. It has the same signature as sync.Map.Range.
+//
+// Range operates on a point-in-time snapshot of the Map taken
+// when it is called, so it's safe for f to modify the Map,
+// but neither changes made by f nor concurrent changes made by other
+// goroutines will be observed by Range.
+func (c *Map[Key, Value]) Range(f func(key Key, value Value) bool) {
+	for iter := c.Iterator(); iter.Next(); {
+		if !f(iter.Key(), iter.Value()) {
+			return
+		}
+	}
+}
+
+// Keys returns an iterator over all the keys in the Map.
+// Like All, each iteration operates on a point-in-time snapshot.
+func (c *Map[Key, Value]) Keys() iter.Seq[Key] {
+	return func(yield func(Key) bool) {
+		for iter := c.Iterator(); iter.Next(); {
+			if !yield(iter.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over all the values in the Map.
+// Like All, each iteration operates on a point-in-time snapshot.
+func (c *Map[Key, Value]) Values() iter.Seq[Value] {
+	return func(yield func(Value) bool) {
+		for iter := c.Iterator(); iter.Next(); {
+			if !yield(iter.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Equal reports whether c and other hold the same keys, with
+// eqVal reporting true for the values of each key. Each key in c is
+// looked up in other using other's hash and equality functions.
+// The comparison is made between point-in-time snapshots of both maps.
+func (c *Map[Key, Value]) Equal(other *Map[Key, Value], eqVal func(Value, Value) bool) bool {
+	c0, c1 := c.RClone(), other.RClone()
+	if c0.Len() != c1.Len() {
+		return false
+	}
+	for iter := c0.Iterator(); iter.Next(); {
+		v1, ok := c1.lookup(&mapEntry[Key, Value]{
+			key:  iter.Key(),
+			hash: uint32(c1.hashFunc(iter.Key())),
+		})
+		if !ok || !eqVal(iter.Value(), v1) {
+			return false
+		}
+	}
+	return true
+}
+
 // Iterator returns an iterator over the entries of the Map.
 func (c *Map[Key, Value]) Iterator() *Iter[Key, Value] {
 	iter := &Iter[Key, Value]{
@@ -358,10 +590,10 @@ func (c *Map[Key, Value]) assertReadWrite() {
 	}
 }
 
-func (c *Map[Key, Value]) insert(entry *mapEntry[Key, Value]) {
+func (c *Map[Key, Value]) insert(entry *mapEntry[Key, Value], cond func(old *mapEntry[Key, Value]) bool) {
 	root := c.readRoot()
-	if !c.iinsert(root, entry, 0, nil, root.gen) {
-		c.insert(entry)
+	if !c.iinsert(root, entry, cond, 0, nil, root.gen) {
+		c.insert(entry, cond)
 	}
 }
 
@@ -374,18 +606,23 @@ func (c *Map[Key, Value]) lookup(entry *mapEntry[Key, Value]) (Value, bool) {
 	return result, exists
 }
 
-func (c *Map[Key, Value]) remove(entry *mapEntry[Key, Value]) (Value, bool) {
+func (c *Map[Key, Value]) remove(entry *mapEntry[Key, Value], cond func(old *mapEntry[Key, Value]) bool) (Value, bool) {
 	root := c.readRoot()
-	result, exists, ok := c.iremove(root, entry, 0, nil, root.gen)
+	result, exists, ok := c.iremove(root, entry, cond, 0, nil, root.gen)
 	for !ok {
-		return c.remove(entry)
+		return c.remove(entry, cond)
 	}
 	return result, exists
 }
 
 // iinsert attempts to insert the entry into the Map. If false is returned,
 // the operation should be retried.
-func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Value], lev uint, parent *iNode[Key, Value], startGen *generation) bool {
+//
+// If cond is non-nil, it is called with the entry currently held for
+// the key (nil if there is none) before the entry is inserted. If it
+// returns false, the Map is left unchanged and iinsert returns true.
+// Note that cond will be called again if the operation is retried.
+func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Value], cond func(old *mapEntry[Key, Value]) bool, lev uint, parent *iNode[Key, Value], startGen *generation) bool {
 	// Linearization point.
 	main := gcasRead(i, c)
 	switch {
@@ -396,6 +633,9 @@ func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Val
 			// If the relevant bit is not in the bitmap, then a copy of the
 			// cNode with the new entry is created. The linearization point is
 			// a successful CAS.
+			if cond != nil && !cond(nil) {
+				return true
+			}
 			rn := cn
 			if cn.gen != i.gen {
 				rn = cn.renewed(i.gen, c)
@@ -412,10 +652,10 @@ func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Val
 		case *iNode[Key, Value]:
 			// If the branch is an I-node, then iinsert is called recursively.
 			if startGen == branch.gen {
-				return c.iinsert(branch, entry, lev+w, i, startGen)
+				return c.iinsert(branch, entry, cond, lev+w, i, startGen)
 			}
 			if gcas(i, main, &mainNode[Key, Value]{cNode: cn.renewed(startGen, c)}, c) {
-				return c.iinsert(i, entry, lev, parent, startGen)
+				return c.iinsert(i, entry, cond, lev, parent, startGen)
 			}
 			return false
 		case *sNode[Key, Value]:
@@ -428,6 +668,9 @@ func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Val
 				// I-node at the respective position. The new Inode has its
 				// main node pointing to a C-node with both keys. The
 				// linearization point is a successful CAS.
+				if cond != nil && !cond(nil) {
+					return true
+				}
 				rn := cn
 				if cn.gen != i.gen {
 					rn = cn.renewed(i.gen, c)
@@ -440,6 +683,9 @@ func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Val
 			// If the key in the S-node is equal to the key being inserted,
 			// then the C-node is replaced with its updated version with a new
 			// S-node. The linearization point is a successful CAS.
+			if cond != nil && !cond(sn.entry) {
+				return true
+			}
 			ncn := &mainNode[Key, Value]{cNode: cn.updated(pos, &sNode[Key, Value]{entry}, i.gen)}
 			return gcas(i, main, ncn, c)
 		default:
@@ -449,6 +695,9 @@ func (c *Map[Key, Value]) iinsert(i *iNode[Key, Value], entry *mapEntry[Key, Val
 		clean(parent, lev-w, c)
 		return false
 	case main.lNode != nil:
+		if cond != nil && !cond(main.lNode.find(entry, c.eqFunc)) {
+			return true
+		}
 		nln := &mainNode[Key, Value]{lNode: main.lNode.inserted(entry, c.eqFunc)}
 		return gcas(i, main, nln, c)
 	default:
@@ -516,7 +765,12 @@ func (c *Map[Key, Value]) ilookup(i *iNode[Key, Value], entry *mapEntry[Key, Val
 // values are the entry value and whether or not the entry was contained in the
 // Map. The last bool indicates if the operation succeeded. False means it
 // should be retried.
-func (c *Map[Key, Value]) iremove(i *iNode[Key, Value], entry *mapEntry[Key, Value], lev uint, parent *iNode[Key, Value], startGen *generation) (Value, bool, bool) {
+//
+// If cond is non-nil, it is called with the entry currently held for
+// the key before it is removed. If it returns false, the Map is left
+// unchanged and iremove returns as if the key was not found.
+// As with iinsert, cond will be called again if the operation is retried.
+func (c *Map[Key, Value]) iremove(i *iNode[Key, Value], entry *mapEntry[Key, Value], cond func(old *mapEntry[Key, Value]) bool, lev uint, parent *iNode[Key, Value], startGen *generation) (Value, bool, bool) {
 	// Linearization point.
 	main := gcasRead(i, c)
 	switch {
@@ -536,10 +790,10 @@ func (c *Map[Key, Value]) iremove(i *iNode[Key, Value], entry *mapEntry[Key, Val
 			// recursively at the next level.
 			in := branch
 			if startGen == in.gen {
-				return c.iremove(in, entry, lev+w, i, startGen)
+				return c.iremove(in, entry, cond, lev+w, i, startGen)
 			}
 			if gcas(i, main, &mainNode[Key, Value]{cNode: cn.renewed(startGen, c)}, c) {
-				return c.iremove(i, entry, lev, parent, startGen)
+				return c.iremove(i, entry, cond, lev, parent, startGen)
 			}
 			return z[Value](), false, false
 		case *sNode[Key, Value]:
@@ -556,6 +810,9 @@ func (c *Map[Key, Value]) iremove(i *iNode[Key, Value], entry *mapEntry[Key, Val
 			//  substitute the old C-node with the copied C-node, thus removing
 			//  the S-node with the given key from the trie – this is the
 			//  linearization point
+			if cond != nil && !cond(sn.entry) {
+				return z[Value](), false, true
+			}
 			ncn := cn.removed(pos, flag, i.gen)
 			cntr := toContracted(ncn, lev)
 			if gcas(i, main, cntr, c) {
@@ -575,6 +832,10 @@ func (c *Map[Key, Value]) iremove(i *iNode[Key, Value], entry *mapEntry[Key, Val
 		clean(parent, lev-w, c)
 		return z[Value](), false, false
 	case main.lNode != nil:
+		old := main.lNode.find(entry, c.eqFunc)
+		if old == nil || (cond != nil && !cond(old)) {
+			return z[Value](), false, true
+		}
 		nln := &mainNode[Key, Value]{
 			lNode: main.lNode.removed(entry, c.eqFunc),
 		}
@@ -583,10 +844,9 @@ func (c *Map[Key, Value]) iremove(i *iNode[Key, Value], entry *mapEntry[Key, Val
 			nln = entomb(nln.lNode.head)
 		}
 		if gcas(i, main, nln, c) {
-			val, ok := main.lNode.lookup(entry, c.eqFunc)
-			return val, ok, true
+			return old.value, true, true
 		}
-		return z[Value](), false, true
+		return z[Value](), false, false
 	default:
 		panic("Map is in an invalid state")
 	}
@@ -751,12 +1011,21 @@ type lNode[Key, Value any] struct {
 // lookup returns the value at the given entry in the L-node or returns false
 // if it's not contained.
 func (l *lNode[Key, Value]) lookup(e *mapEntry[Key, Value], eq func(Key, Key) bool) (Value, bool) {
+	if entry := l.find(e, eq); entry != nil {
+		return entry.value, true
+	}
+	return z[Value](), false
+}
+
+// find returns the entry in the L-node with the same key as e,
+// or nil if there is none.
+func (l *lNode[Key, Value]) find(e *mapEntry[Key, Value], eq func(Key, Key) bool) *mapEntry[Key, Value] {
 	for ; l != nil; l = l.tail {
 		if eq(e.key, l.head.entry.key) {
-			return l.head.entry.value, true
+			return l.head.entry
 		}
 	}
-	return z[Value](), false
+	return nil
 }
 
 // inserted creates a new L-node with the added entry.
@@ -18,6 +18,8 @@ package ctrie
 
 import (
 	"bytes"
+	"maps"
+	"slices"
 	"strconv"
 	"sync"
 	"testing"
@@ -377,6 +379,20 @@ func TestLen(t *testing.T) {
 	assertEqual(t, 10, ctrie.Len())
 }
 
+func TestCountFunc(t *testing.T) {
+	ctrie := NewWithFuncs[[]byte, int](bytes.Equal, BytesHash)
+	for i := 0; i < 10; i++ {
+		ctrie.Set([]byte(strconv.Itoa(i)), i)
+	}
+	isEven := func(_ []byte, v int) bool {
+		return v%2 == 0
+	}
+	assertEqual(t, 5, ctrie.CountFunc(isEven))
+
+	ctrie.Clear()
+	assertEqual(t, 0, ctrie.CountFunc(isEven))
+}
+
 func TestClear(t *testing.T) {
 	ctrie := NewWithFuncs[[]byte, int](bytes.Equal, BytesHash)
 	for i := 0; i < 10; i++ {
@@ -408,6 +424,332 @@ func TestHashCollision(t *testing.T) {
 	assertFalse(t, exists)
 }
 
+func TestCollect(t *testing.T) {
+	m := make(map[String]int)
+	for i := 0; i < 100; i++ {
+		m[String(strconv.Itoa(i))] = i
+	}
+	ctrie := Collect(maps.All(m))
+	assertEqual(t, len(m), ctrie.Len())
+	for k, v := range m {
+		val, ok := ctrie.Get(k)
+		assertTrue(t, ok)
+		assertEqual(t, v, val)
+	}
+
+	// SetAll replaces existing values.
+	ctrie.SetAll(maps.All(map[String]int{"0": 1000, "new": 2000}))
+	assertEqual(t, len(m)+1, ctrie.Len())
+	val, _ := ctrie.Get("0")
+	assertEqual(t, 1000, val)
+	val, _ = ctrie.Get("new")
+	assertEqual(t, 2000, val)
+
+	// SetAll panics on a read-only clone.
+	func() {
+		defer func() {
+			assertNotNil(t, recover())
+		}()
+		ctrie.RClone().SetAll(maps.All(m))
+	}()
+}
+
+func TestGetOrSet(t *testing.T) {
+	ctrie := New[String, int]()
+	val, loaded := ctrie.GetOrSet("a", 1)
+	assertFalse(t, loaded)
+	assertEqual(t, 1, val)
+	val, loaded = ctrie.GetOrSet("a", 2)
+	assertTrue(t, loaded)
+	assertEqual(t, 1, val)
+	val, _ = ctrie.Get("a")
+	assertEqual(t, 1, val)
+}
+
+func TestGetOrSetConcurrent(t *testing.T) {
+	// Use a hash function with lots of collisions so that
+	// we exercise the L-node code path too.
+	ctrie := NewWithFuncs[string, int](nil, func(s string) uint64 {
+		return uint64(len(s))
+	})
+	const (
+		goroutines = 10
+		keys       = 100
+	)
+	var wg sync.WaitGroup
+	results := make([][]int, goroutines)
+	for i := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range keys {
+				val, _ := ctrie.GetOrSet(strconv.Itoa(k), i)
+				results[i] = append(results[i], val)
+			}
+		}()
+	}
+	wg.Wait()
+	// All goroutines must agree on the value stored
+	// for each key.
+	for k := range keys {
+		val, ok := ctrie.Get(strconv.Itoa(k))
+		assertTrue(t, ok)
+		for i := range goroutines {
+			assertEqual(t, val, results[i][k])
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	ctrie := New[String, int]()
+	incr := func(v int, exists bool) (int, bool) {
+		return v + 1, true
+	}
+	ctrie.Update("a", incr)
+	ctrie.Update("a", incr)
+	val, ok := ctrie.Get("a")
+	assertTrue(t, ok)
+	assertEqual(t, 2, val)
+
+	// Returning false deletes the entry.
+	ctrie.Update("a", func(v int, exists bool) (int, bool) {
+		assertTrue(t, exists)
+		assertEqual(t, 2, v)
+		return 0, false
+	})
+	_, ok = ctrie.Get("a")
+	assertFalse(t, ok)
+
+	// Deleting a nonexistent entry is a no-op.
+	ctrie.Update("b", func(v int, exists bool) (int, bool) {
+		assertFalse(t, exists)
+		return 0, false
+	})
+	assertEqual(t, 0, ctrie.Len())
+}
+
+func TestUpdateConcurrent(t *testing.T) {
+	// Use a hash function with lots of collisions so that
+	// we exercise the L-node code path too.
+	ctrie := NewWithFuncs[string, int](nil, func(s string) uint64 {
+		return uint64(len(s))
+	})
+	const (
+		goroutines = 10
+		keys       = 20
+		iterations = 100
+	)
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				for k := range keys {
+					ctrie.Update(strconv.Itoa(k), func(v int, exists bool) (int, bool) {
+						// Delete even keys when they reach a
+						// multiple of 100; they'll start from
+						// zero again next time around.
+						if k%2 == 0 && exists && v%100 == 99 {
+							return 0, false
+						}
+						return v + 1, true
+					})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for k := range keys {
+		val, _ := ctrie.Get(strconv.Itoa(k))
+		if k%2 == 0 {
+			// 1000 updates, each of which either increments
+			// or (every 100th) deletes.
+			assertEqual(t, 1000%100, val)
+		} else {
+			assertEqual(t, goroutines*iterations, val)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	ctrie := New[String, int]()
+	m := make(map[String]int)
+	for i := 0; i < 100; i++ {
+		m[String(strconv.Itoa(i))] = i
+		ctrie.Set(String(strconv.Itoa(i)), i)
+	}
+	got := make(map[String]int)
+	for k, v := range ctrie.All() {
+		// Modifications during iteration are not observed.
+		ctrie.Set(k+"x", v)
+		got[k] = v
+	}
+	assertTrue(t, maps.Equal(got, m))
+	assertEqual(t, 200, ctrie.Len())
+
+	n := 0
+	for range ctrie.All() {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	assertEqual(t, 10, n)
+}
+
+func TestRange(t *testing.T) {
+	ctrie := New[String, int]()
+	for i := 0; i < 100; i++ {
+		ctrie.Set(String(strconv.Itoa(i)), i)
+	}
+	seen := make(map[String]int)
+	ctrie.Range(func(k String, v int) bool {
+		// Deleting and adding entries during Range
+		// does not affect what Range sees.
+		ctrie.Delete(k)
+		ctrie.Set(k+"x", v)
+		seen[k] = v
+		return true
+	})
+	assertEqual(t, 100, len(seen))
+	for k, v := range seen {
+		assertEqual(t, k, String(strconv.Itoa(v)))
+	}
+	assertEqual(t, 100, ctrie.Len())
+
+	n := 0
+	ctrie.Range(func(String, int) bool {
+		n++
+		return n < 10
+	})
+	assertEqual(t, 10, n)
+}
+
+func TestKeysValues(t *testing.T) {
+	m := make(map[String]int)
+	for i := 0; i < 100; i++ {
+		m[String(strconv.Itoa(i))] = i
+	}
+	ctrie := Collect(maps.All(m))
+	keys := slices.Collect(ctrie.Keys())
+	slices.Sort(keys)
+	assertTrue(t, slices.Equal(slices.Sorted(maps.Keys(m)), keys))
+	values := slices.Collect(ctrie.Values())
+	slices.Sort(values)
+	assertTrue(t, slices.Equal(slices.Sorted(maps.Values(m)), values))
+}
+
+func TestEqual(t *testing.T) {
+	eq := func(v0, v1 int) bool {
+		return v0 == v1
+	}
+	ctrie := New[String, int]()
+	for i := 0; i < 100; i++ {
+		ctrie.Set(String(strconv.Itoa(i)), i)
+	}
+	assertTrue(t, ctrie.Equal(ctrie, eq))
+	clone := ctrie.Clone()
+	assertTrue(t, ctrie.Equal(clone, eq))
+	assertTrue(t, ctrie.RClone().Equal(ctrie, eq))
+
+	clone.Set("50", 1000)
+	assertFalse(t, ctrie.Equal(clone, eq))
+	assertFalse(t, clone.Equal(ctrie, eq))
+	assertTrue(t, ctrie.Equal(clone, func(v0, v1 int) bool {
+		return v0 == v1 || v1 == 1000
+	}))
+
+	clone.Delete("50")
+	assertFalse(t, ctrie.Equal(clone, eq))
+	clone.Set("new", 50)
+	assertFalse(t, ctrie.Equal(clone, eq))
+
+	assertTrue(t, New[String, int]().Equal(New[String, int](), eq))
+
+	// Maps with different hash functions can still be equal.
+	other := NewWithFuncs[String, int](func(s0, s1 String) bool {
+		return s0 == s1
+	}, func(s String) uint64 {
+		return uint64(len(s))
+	})
+	for k, v := range ctrie.All() {
+		other.Set(k, v)
+	}
+	assertTrue(t, ctrie.Equal(other, eq))
+	assertTrue(t, other.Equal(ctrie, eq))
+}
+
+func TestReadOnlyLenCached(t *testing.T) {
+	ctrie := New[String, int]()
+	for i := 0; i < 10; i++ {
+		ctrie.Set(String(strconv.Itoa(i)), i)
+	}
+	clone := ctrie.RClone()
+	assertEqual(t, int64(0), clone.size.Load())
+	assertEqual(t, 10, clone.Len())
+	assertEqual(t, int64(11), clone.size.Load())
+	// Poke a different value into the cache so that we can
+	// tell that the second call doesn't traverse the map.
+	clone.size.Store(100)
+	assertEqual(t, 99, clone.Len())
+
+	// A new read-only clone doesn't inherit the cached size.
+	ctrie.Set("new", 10)
+	clone = ctrie.RClone()
+	assertEqual(t, 11, clone.Len())
+
+	// The size is never cached for a read-write map.
+	assertEqual(t, 11, ctrie.Len())
+	assertEqual(t, int64(0), ctrie.size.Load())
+}
+
+func TestSetManyDeleteMany(t *testing.T) {
+	ctrie := New[String, int]()
+	m := make(map[String]int)
+	for i := 0; i < 1000; i++ {
+		m[String(strconv.Itoa(i))] = i
+	}
+	// Clone concurrently so that SetMany must cope with
+	// the root changing underfoot.
+	done := make(chan struct{})
+	var clones []*Map[String, int]
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			clones = append(clones, ctrie.Clone())
+		}
+	}()
+	ctrie.SetMany(maps.All(m))
+	<-done
+	assertEqual(t, len(m), ctrie.Len())
+	for k, v := range m {
+		val, ok := ctrie.Get(k)
+		assertTrue(t, ok)
+		assertEqual(t, v, val)
+	}
+	// Clones see a prefix of the insertions only.
+	for _, clone := range clones {
+		assertTrue(t, clone.Len() <= len(m))
+	}
+
+	snapshot := ctrie.RClone()
+	ctrie.DeleteMany(func(yield func(String) bool) {
+		for k, v := range m {
+			if v%2 == 0 && !yield(k) {
+				return
+			}
+		}
+		yield("nonexistent")
+	})
+	assertEqual(t, len(m)/2, ctrie.Len())
+	for k, v := range m {
+		_, ok := ctrie.Get(k)
+		assertEqual(t, v%2 != 0, ok)
+	}
+	assertEqual(t, len(m), snapshot.Len())
+}
+
 func BenchmarkSet(b *testing.B) {
 	ctrie := NewWithFuncs[[]byte, int](bytes.Equal, BytesHash)
 	b.ResetTimer()
@@ -416,6 +758,38 @@ func BenchmarkSet(b *testing.B) {
 	}
 }
 
+func BenchmarkSetLoop(b *testing.B) {
+	keys := benchKeys(1000)
+	for i := 0; i < b.N; i++ {
+		ctrie := New[String, int]()
+		for i, key := range keys {
+			ctrie.Set(key, i)
+		}
+	}
+}
+
+func BenchmarkSetMany(b *testing.B) {
+	keys := benchKeys(1000)
+	for i := 0; i < b.N; i++ {
+		ctrie := New[String, int]()
+		ctrie.SetMany(func(yield func(String, int) bool) {
+			for i, key := range keys {
+				if !yield(key, i) {
+					return
+				}
+			}
+		})
+	}
+}
+
+func benchKeys(n int) []String {
+	keys := make([]String, n)
+	for i := range keys {
+		keys[i] = String(strconv.Itoa(i))
+	}
+	return keys
+}
+
 func BenchmarkGet(b *testing.B) {
 	numItems := 1000
 	ctrie := NewWithFuncs[[]byte, int](bytes.Equal, BytesHash)
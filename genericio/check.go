@@ -0,0 +1,56 @@
+package genericio
+
+import "slices"
+
+// CheckReader returns a Reader that reads from r, intended for use
+// in tests to catch Reader implementations that violate the rule that
+// they must not retain p.
+//
+// Each Read call passes r a newly allocated scratch buffer rather
+// than the caller's slice. After r returns, the data is copied to the
+// caller's slice and the scratch buffer is overwritten with zero
+// values, so a reader that holds on to the slice it was given will
+// observe corrupted data if it looks at it later, and any later writes
+// it makes to the slice will not be seen by the caller.
+func CheckReader[T any](r Reader[T]) Reader[T] {
+	return &checkReader[T]{r: r}
+}
+
+type checkReader[T any] struct {
+	r Reader[T]
+}
+
+func (c *checkReader[T]) Read(p []T) (n int, err error) {
+	// Use a new buffer each time so that writes made through
+	// a slice retained from an earlier call are never seen.
+	scratch := make([]T, len(p))
+	n, err = c.r.Read(scratch)
+	copy(p, scratch[:n])
+	clear(scratch)
+	return n, err
+}
+
+// CheckWriter returns a Writer that writes to w, intended for use
+// in tests to catch Writer implementations that violate the rule that
+// they must not retain p.
+//
+// Each Write call passes w a copy of the caller's data in a newly
+// allocated scratch buffer. After w returns, the scratch buffer is overwritten with zero
+// values, so a writer that holds on to the slice it was given
+// will observe corrupted data if it looks at it later.
+func CheckWriter[T any](w Writer[T]) Writer[T] {
+	return &checkWriter[T]{w: w}
+}
+
+type checkWriter[T any] struct {
+	w Writer[T]
+}
+
+func (c *checkWriter[T]) Write(p []T) (n int, err error) {
+	// As for checkReader, use a new buffer each time so that
+	// the data seen through a retained slice is always zero.
+	scratch := slices.Clone(p)
+	n, err = c.w.Write(scratch)
+	clear(scratch)
+	return n, err
+}
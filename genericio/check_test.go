@@ -0,0 +1,127 @@
+package genericio
+
+import (
+	"reflect"
+	"testing"
+)
+
+// retainingReader is a badly behaved reader that retains the
+// slice passed to Read and uses its contents in the next call:
+// each element it returns is one more than the first element
+// of the previous read.
+type retainingReader struct {
+	prev []int
+	n    int
+}
+
+func (r *retainingReader) Read(p []int) (int, error) {
+	if r.n == 0 {
+		return 0, EOF
+	}
+	r.n--
+	if r.prev == nil {
+		p[0] = 1
+	} else {
+		p[0] = r.prev[0] + 1
+	}
+	r.prev = p
+	return 1, nil
+}
+
+func readAllInts(r Reader[int]) []int {
+	var got []int
+	buf := make([]int, 4)
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err != nil {
+			return got
+		}
+	}
+}
+
+func TestCheckReader(t *testing.T) {
+	// Without the checker, the retaining reader appears to work.
+	if got, want := readAllInts(&retainingReader{n: 3}), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result from unchecked reader; got %v want %v", got, want)
+	}
+	// With the checker, the retained slice is scribbled over, so
+	// the retaining reader produces wrong results.
+	if got, want := readAllInts(CheckReader[int](&retainingReader{n: 3})), []int{1, 1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("checker did not expose retaining reader; got %v want %v", got, want)
+	}
+	// A well behaved reader is unaffected.
+	r := &sliceReader[int]{items: []int{1, 2, 3, 4, 5, 6}}
+	if got, want := readAllInts(CheckReader[int](r)), []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected result from checked reader; got %v want %v", got, want)
+	}
+}
+
+// lateWritingReader is a badly behaved reader that retains the
+// slice passed to Read and writes the data for each call through
+// the slice it was given in the previous call.
+type lateWritingReader struct {
+	prev []int
+	n    int
+}
+
+func (r *lateWritingReader) Read(p []int) (int, error) {
+	if r.n == 0 {
+		return 0, EOF
+	}
+	r.n--
+	dst := p
+	if r.prev != nil {
+		dst = r.prev
+	}
+	dst[0] = r.n + 1
+	r.prev = p
+	return 1, nil
+}
+
+func TestCheckReaderLateWrite(t *testing.T) {
+	// Without the checker, the reader appears to work because
+	// the caller passes the same buffer each time.
+	if got, want := readAllInts(&lateWritingReader{n: 3}), []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result from unchecked reader; got %v want %v", got, want)
+	}
+	// With the checker, writes through the stale slice are lost.
+	if got, want := readAllInts(CheckReader[int](&lateWritingReader{n: 3})), []int{3, 0, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("checker did not expose late-writing reader; got %v want %v", got, want)
+	}
+}
+
+// retainingWriter is a badly behaved writer that retains
+// the slices passed to Write.
+type retainingWriter struct {
+	written [][]int
+}
+
+func (w *retainingWriter) Write(p []int) (int, error) {
+	w.written = append(w.written, p)
+	return len(p), nil
+}
+
+func TestCheckWriter(t *testing.T) {
+	w := new(retainingWriter)
+	cw := CheckWriter[int](w)
+	cw.Write([]int{1, 2})
+	cw.Write([]int{3, 4})
+	if got, want := w.written, [][]int{{0, 0}, {0, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("checker did not expose retaining writer; got %v want %v", got, want)
+	}
+}
+
+// sliceReader is a Reader that reads from a slice.
+type sliceReader[T any] struct {
+	items []T
+}
+
+func (r *sliceReader[T]) Read(p []T) (int, error) {
+	if len(r.items) == 0 {
+		return 0, EOF
+	}
+	n := copy(p, r.items)
+	r.items = r.items[n:]
+	return n, nil
+}
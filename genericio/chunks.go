@@ -0,0 +1,70 @@
+package genericio
+
+import (
+	"iter"
+	"slices"
+)
+
+// Chunks returns an iterator that reads r in successive blocks of size
+// elements. Every block holds exactly size elements except possibly
+// the last one, which may be shorter.
+//
+// Each block is yielded with a nil error; it is a fresh slice that
+// the caller may retain. If reading fails with an error other than
+// EOF, the iterator yields any data read before the error along with
+// the error, and then stops.
+//
+// Chunks panics if size is not positive.
+func Chunks[T any](r Reader[T], size int) iter.Seq2[[]T, error] {
+	if size <= 0 {
+		panic("genericio.Chunks called with non-positive size")
+	}
+	return func(yield func([]T, error) bool) {
+		buf := make([]T, size)
+		for {
+			n, err := ReadFull(r, buf)
+			switch err {
+			case nil:
+				if !yield(slices.Clone(buf), nil) {
+					return
+				}
+				continue
+			case EOF:
+				return
+			case ErrUnexpectedEOF:
+				yield(slices.Clone(buf[:n]), nil)
+				return
+			}
+			yield(slices.Clone(buf[:n]), err)
+			return
+		}
+	}
+}
+
+// itemsReadSize holds the size of the buffer used by Items.
+const itemsReadSize = 512
+
+// Items returns an iterator over the individual elements read from r.
+// Each element is yielded with a nil error. If reading fails with an
+// error other than EOF, the iterator yields the zero value along with
+// the error, and then stops; at EOF it just stops.
+func Items[T any](r Reader[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		buf := make([]T, itemsReadSize)
+		for {
+			n, err := r.Read(buf)
+			for _, x := range buf[:n] {
+				if !yield(x, nil) {
+					return
+				}
+			}
+			if err == EOF {
+				return
+			}
+			if err != nil {
+				yield(*new(T), err)
+				return
+			}
+		}
+	}
+}
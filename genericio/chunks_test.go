@@ -0,0 +1,106 @@
+package genericio
+
+import (
+	"reflect"
+	"testing"
+)
+
+var chunksTests = []struct {
+	testName string
+	items    []int
+	size     int
+	want     [][]int
+}{{
+	testName: "exact-multiple",
+	items:    []int{1, 2, 3, 4, 5, 6},
+	size:     3,
+	want:     [][]int{{1, 2, 3}, {4, 5, 6}},
+}, {
+	testName: "short-final-chunk",
+	items:    []int{1, 2, 3, 4, 5, 6, 7},
+	size:     3,
+	want:     [][]int{{1, 2, 3}, {4, 5, 6}, {7}},
+}, {
+	testName: "empty",
+	size:     3,
+}}
+
+func TestChunks(t *testing.T) {
+	for _, test := range chunksTests {
+		t.Run(test.testName, func(t *testing.T) {
+			r := &sliceReader[int]{items: test.items}
+			var got [][]int
+			for chunk, err := range Chunks[int](r, test.size) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, chunk)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("unexpected chunks; got %v want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChunksError(t *testing.T) {
+	// Read one element at a time so that Chunks
+	// has to assemble each chunk from several reads.
+	data := []byte("hello")
+	r := readerFunc(func(p []byte) (int, error) {
+		if len(data) == 0 {
+			return 0, errShort
+		}
+		n := copy(p, data[:1])
+		data = data[n:]
+		return n, nil
+	})
+	var got []string
+	var gotErr error
+	for chunk, err := range Chunks[byte](r, 2) {
+		got = append(got, string(chunk))
+		gotErr = err
+	}
+	if want := []string{"he", "ll", "o"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected chunks; got %q want %q", got, want)
+	}
+	if gotErr != errShort {
+		t.Errorf("unexpected error; got %v want %v", gotErr, errShort)
+	}
+}
+
+func TestItems(t *testing.T) {
+	items := make([]int, itemsReadSize*2+10)
+	for i := range items {
+		items[i] = i
+	}
+	var got []int
+	for x, err := range Items[int](&sliceReader[int]{items: items}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, x)
+	}
+	if !reflect.DeepEqual(got, items) {
+		t.Errorf("unexpected items; got %v want %v", got, items)
+	}
+}
+
+func TestItemsError(t *testing.T) {
+	r := MultiReader[int](&sliceReader[int]{items: []int{1, 2, 3}}, errorReader{errShort})
+	var got []int
+	var gotErr error
+	for x, err := range Items(r) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, x)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected items; got %v want %v", got, want)
+	}
+	if gotErr != errShort {
+		t.Errorf("unexpected error; got %v want %v", gotErr, errShort)
+	}
+}
@@ -0,0 +1,165 @@
+package genericio
+
+import (
+	"errors"
+	"slices"
+	"sync"
+)
+
+// ErrClosedReader is returned by Read on a reader returned by
+// ConcurrentReader after it has been closed.
+var ErrClosedReader = errors.New("genericio: read on closed reader")
+
+// concurrentReadSize holds the size of the buffer used by each
+// goroutine started by ConcurrentReader.
+const concurrentReadSize = 512
+
+// ConcurrentReader returns a ReadCloser that reads from all the
+// provided readers concurrently, each in its own goroutine, and
+// returns data in the order that it arrives. Data from any single
+// reader is returned in order, but the interleaving of data from
+// different readers is non-deterministic.
+//
+// Once all the readers have returned EOF, Read will return EOF. If
+// any of the readers returns a non-nil, non-EOF error, Read will
+// return that error and all subsequent calls will return it too.
+//
+// Close stops the goroutines. It does not interrupt a Read call
+// that's in progress on one of the underlying readers, so a
+// goroutine might not exit until such a call returns.
+func ConcurrentReader[T any](readers ...Reader[T]) ReadCloser[T] {
+	return newConcurrentReader(nil, readers)
+}
+
+// BoundedConcurrentReader is like ConcurrentReader except that at
+// most maxActive of the readers are read from at any one time.
+// Each reader occupies one of the maxActive slots from its first
+// Read until it returns an error (including EOF), at which point
+// another reader can start.
+//
+// It panics if maxActive is not positive.
+func BoundedConcurrentReader[T any](maxActive int, readers ...Reader[T]) ReadCloser[T] {
+	if maxActive <= 0 {
+		panic("genericio.BoundedConcurrentReader called with non-positive maxActive")
+	}
+	return newConcurrentReader(make(chan struct{}, maxActive), readers)
+}
+
+func newConcurrentReader[T any](sem chan struct{}, readers []Reader[T]) *concurrentReader[T] {
+	cr := &concurrentReader[T]{
+		c:         make(chan concurrentChunk[T]),
+		done:      make(chan struct{}),
+		sem:       sem,
+		remaining: len(readers),
+	}
+	for _, r := range readers {
+		go cr.reader(r)
+	}
+	return cr
+}
+
+type concurrentReader[T any] struct {
+	c         chan concurrentChunk[T]
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// sem holds a token for each active reader
+	// when the number of active readers is bounded.
+	sem chan struct{}
+
+	// The following fields are only accessed by Read.
+
+	// buf holds data received but not yet returned.
+	buf []T
+	// remaining holds the number of readers that
+	// have not yet returned EOF.
+	remaining int
+	// err holds any error that's been returned.
+	err error
+}
+
+// concurrentChunk holds some data or an error
+// from one of the readers.
+type concurrentChunk[T any] struct {
+	data []T
+	err  error
+}
+
+func (cr *concurrentReader[T]) reader(r Reader[T]) {
+	if cr.sem != nil {
+		select {
+		case cr.sem <- struct{}{}:
+		case <-cr.done:
+			return
+		}
+		defer func() {
+			<-cr.sem
+		}()
+	}
+	buf := make([]T, concurrentReadSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 && !cr.send(concurrentChunk[T]{data: slices.Clone(buf[:n])}) {
+			return
+		}
+		if err != nil {
+			cr.send(concurrentChunk[T]{err: err})
+			return
+		}
+	}
+}
+
+// send sends a chunk to Read and reports whether
+// it was sent before the reader was closed.
+func (cr *concurrentReader[T]) send(chunk concurrentChunk[T]) bool {
+	select {
+	case cr.c <- chunk:
+		return true
+	case <-cr.done:
+		return false
+	}
+}
+
+func (cr *concurrentReader[T]) Read(p []T) (int, error) {
+	select {
+	case <-cr.done:
+		return 0, ErrClosedReader
+	default:
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(cr.buf) == 0 {
+		if cr.err != nil {
+			return 0, cr.err
+		}
+		if cr.remaining == 0 {
+			return 0, EOF
+		}
+		select {
+		case chunk := <-cr.c:
+			switch {
+			case chunk.err == EOF:
+				cr.remaining--
+			case chunk.err != nil:
+				cr.err = chunk.err
+			default:
+				cr.buf = chunk.data
+			}
+		case <-cr.done:
+			return 0, ErrClosedReader
+		}
+	}
+	n := copy(p, cr.buf)
+	cr.buf = cr.buf[n:]
+	return n, nil
+}
+
+// Close stops all the goroutines reading from the underlying
+// readers. It always returns nil.
+func (cr *concurrentReader[T]) Close() error {
+	cr.closeOnce.Do(func() {
+		close(cr.done)
+	})
+	return nil
+}
@@ -0,0 +1,138 @@
+package genericio
+
+import (
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// delayedReader is a Reader that returns a single
+// item at a time from items, sleeping for delay
+// before each one.
+type delayedReader struct {
+	items []int
+	delay time.Duration
+}
+
+func (r *delayedReader) Read(p []int) (int, error) {
+	if len(r.items) == 0 {
+		return 0, EOF
+	}
+	time.Sleep(r.delay)
+	p[0] = r.items[0]
+	r.items = r.items[1:]
+	return 1, nil
+}
+
+func TestConcurrentReader(t *testing.T) {
+	r := ConcurrentReader[int](
+		&delayedReader{items: []int{1, 2, 3}, delay: 3 * time.Millisecond},
+		&delayedReader{items: []int{10, 11}, delay: 5 * time.Millisecond},
+		&delayedReader{},
+		&sliceReader[int]{items: []int{20, 21, 22, 23}},
+	)
+	defer r.Close()
+	got := readAllInts(r)
+	want := []int{1, 2, 3, 10, 11, 20, 21, 22, 23}
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected result; got %v want %v", got, want)
+	}
+	if n, err := r.Read(make([]int, 1)); n != 0 || err != EOF {
+		t.Errorf("unexpected result after EOF; got %v, %v want 0, EOF", n, err)
+	}
+}
+
+func TestConcurrentReaderError(t *testing.T) {
+	rerr := errors.New("read error")
+	r := ConcurrentReader[int](
+		&delayedReader{items: []int{1, 2, 3}, delay: time.Millisecond},
+		MultiReader[int](&sliceReader[int]{items: []int{4}}, errorReader{rerr}),
+	)
+	defer r.Close()
+	buf := make([]int, 10)
+	var err error
+	for err == nil {
+		_, err = r.Read(buf)
+	}
+	if err != rerr {
+		t.Fatalf("unexpected error; got %v want %v", err, rerr)
+	}
+	if _, err := r.Read(buf); err != rerr {
+		t.Errorf("unexpected error on subsequent read; got %v want %v", err, rerr)
+	}
+}
+
+func TestConcurrentReaderClose(t *testing.T) {
+	r := ConcurrentReader[int](
+		&delayedReader{items: []int{1, 2, 3}, delay: time.Millisecond},
+	)
+	if err := r.Close(); err != nil {
+		t.Fatalf("unexpected error from Close: %v", err)
+	}
+	if _, err := r.Read(make([]int, 1)); err != ErrClosedReader {
+		t.Errorf("unexpected error after Close; got %v want %v", err, ErrClosedReader)
+	}
+	// Closing twice is OK.
+	if err := r.Close(); err != nil {
+		t.Fatalf("unexpected error from second Close: %v", err)
+	}
+}
+
+func TestBoundedConcurrentReader(t *testing.T) {
+	const maxActive = 3
+	var active, maxSeen atomic.Int64
+	var readers []Reader[int]
+	var want []int
+	for i := range 10 {
+		items := []int{i * 10, i*10 + 1, i*10 + 2}
+		want = append(want, items...)
+		readers = append(readers, &probeReader{
+			r:       &delayedReader{items: items, delay: time.Millisecond},
+			active:  &active,
+			maxSeen: &maxSeen,
+		})
+	}
+	r := BoundedConcurrentReader(maxActive, readers...)
+	defer r.Close()
+	got := readAllInts(r)
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected result; got %v want %v", got, want)
+	}
+	if n := maxSeen.Load(); n > maxActive {
+		t.Errorf("too many concurrent reads; got %d want at most %d", n, maxActive)
+	}
+}
+
+// probeReader is a Reader that records the maximum number of
+// concurrent Read calls made on all the probeReaders sharing the
+// same counters.
+type probeReader struct {
+	r       Reader[int]
+	active  *atomic.Int64
+	maxSeen *atomic.Int64
+}
+
+func (r *probeReader) Read(p []int) (int, error) {
+	n := r.active.Add(1)
+	defer r.active.Add(-1)
+	for {
+		m := r.maxSeen.Load()
+		if n <= m || r.maxSeen.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	return r.r.Read(p)
+}
+
+// errorReader is a Reader that always returns err.
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]int) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,50 @@
+package genericio
+
+// DedupReader returns a Reader that reads from r, dropping
+// any element that's equal to the element before it,
+// so each run of equal elements is collapsed to one.
+func DedupReader[T comparable](r Reader[T]) Reader[T] {
+	return DedupReaderFunc(r, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// DedupReaderFunc is like DedupReader but uses eq
+// to compare elements.
+func DedupReaderFunc[T any](r Reader[T], eq func(T, T) bool) Reader[T] {
+	return &dedupReader[T]{
+		r:  r,
+		eq: eq,
+	}
+}
+
+type dedupReader[T any] struct {
+	r  Reader[T]
+	eq func(T, T) bool
+	// last holds the last element returned,
+	// which is valid if haveLast is true.
+	last     T
+	haveLast bool
+}
+
+func (dr *dedupReader[T]) Read(p []T) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	// Keep reading until we've got at least one element
+	// to return, because it's possible that all the elements
+	// in a read are duplicates.
+	for n == 0 && err == nil {
+		var nr int
+		nr, err = dr.r.Read(p)
+		for _, x := range p[:nr] {
+			if dr.haveLast && dr.eq(dr.last, x) {
+				continue
+			}
+			p[n] = x
+			n++
+			dr.last, dr.haveLast = x, true
+		}
+	}
+	return n, err
+}
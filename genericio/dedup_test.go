@@ -0,0 +1,43 @@
+package genericio
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDedupReader(t *testing.T) {
+	items := []int{1, 1, 2, 2, 2, 3, 1, 1, 4, 4, 4, 4, 3}
+	// Read in small chunks so that runs span
+	// buffer boundaries.
+	r := DedupReader[int](&sliceReader[int]{items: items})
+	var got []int
+	buf := make([]int, 3)
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if want := []int{1, 2, 3, 1, 4, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected result; got %v want %v", got, want)
+	}
+}
+
+func TestDedupReaderFunc(t *testing.T) {
+	items := [][]int{{1}, {1}, {2}, {1}, {1}}
+	r := DedupReaderFunc(&sliceReader[[]int]{items: items}, func(x, y []int) bool {
+		return reflect.DeepEqual(x, y)
+	})
+	got := make([][]int, len(items))
+	n, err := ReadFull(r, got)
+	if err != ErrUnexpectedEOF {
+		t.Fatalf("unexpected error; got %v want %v", err, ErrUnexpectedEOF)
+	}
+	if want := [][]int{{1}, {2}, {1}}; !reflect.DeepEqual(got[:n], want) {
+		t.Errorf("unexpected result; got %v want %v", got[:n], want)
+	}
+}
@@ -0,0 +1,90 @@
+package genericio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrFrameTooLong is returned by ReadFrame and ReadFrameMax when
+// the length prefix of a frame exceeds the maximum allowed size.
+var ErrFrameTooLong = errors.New("genericio: frame too long")
+
+// DefaultMaxFrameSize holds the maximum frame size, in bytes,
+// accepted by ReadFrame.
+const DefaultMaxFrameSize = 64 << 20
+
+// frameReadSize holds the maximum number of bytes that ReadFrame
+// allocates before it has read them, so that a corrupt length
+// prefix can't cause a huge allocation.
+const frameReadSize = 64 << 10
+
+// WriteFrame writes frame to w preceded by its length encoded
+// as an unsigned varint (see encoding/binary). The frame can
+// be read back with ReadFrame.
+func WriteFrame(w Writer[byte], frame []byte) error {
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(frame)))
+	if _, err := w.Write(prefix[:n]); err != nil {
+		return err
+	}
+	if len(frame) == 0 {
+		return nil
+	}
+	_, err := w.Write(frame)
+	return err
+}
+
+// ReadFrame reads a frame written by WriteFrame from r.
+// It returns EOF only if no bytes were read; if the stream
+// ends part way through a frame, it returns ErrUnexpectedEOF.
+// If the frame is longer than DefaultMaxFrameSize, it returns
+// ErrFrameTooLong.
+func ReadFrame(r Reader[byte]) ([]byte, error) {
+	return ReadFrameMax(r, DefaultMaxFrameSize)
+}
+
+// ReadFrameMax is like ReadFrame except that it returns
+// ErrFrameTooLong for frames longer than maxSize bytes
+// instead of DefaultMaxFrameSize.
+//
+// Memory for the frame is allocated as its data arrives, so a
+// corrupt length prefix within maxSize can't by itself cause
+// a large allocation.
+func ReadFrameMax(r Reader[byte], maxSize int) ([]byte, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = byteReader{r}
+	}
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize < 0 || n > uint64(maxSize) {
+		return nil, ErrFrameTooLong
+	}
+	frame := make([]byte, 0, min(int(n), frameReadSize))
+	for remain := int(n); remain > 0; {
+		chunk := min(remain, frameReadSize)
+		frame = append(frame, make([]byte, chunk)...)
+		if _, err := ReadFull(r, frame[len(frame)-chunk:]); err != nil {
+			if err == EOF {
+				err = ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		remain -= chunk
+	}
+	return frame, nil
+}
+
+// byteReader adapts a Reader[byte] to io.ByteReader.
+type byteReader struct {
+	r Reader[byte]
+}
+
+func (br byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := ReadFull(br.r, b[:])
+	return b[0], err
+}
@@ -0,0 +1,109 @@
+package genericio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	frames := [][]byte{
+		[]byte("hello"),
+		{},
+		[]byte(strings.Repeat("x", 300)),
+		[]byte("world"),
+	}
+	var buf bytes.Buffer
+	for _, f := range frames {
+		if err := WriteFrame(&buf, f); err != nil {
+			t.Fatalf("WriteFrame returned error: %v", err)
+		}
+	}
+	// sliceReader has no ReadByte method, so it exercises
+	// the byte-at-a-time path.
+	for _, r := range []Reader[byte]{
+		bytes.NewReader(buf.Bytes()),
+		&sliceReader[byte]{items: buf.Bytes()},
+	} {
+		var got [][]byte
+		for {
+			f, err := ReadFrame(r)
+			if err == EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("ReadFrame returned error: %v", err)
+			}
+			got = append(got, f)
+		}
+		if !reflect.DeepEqual(got, frames) {
+			t.Errorf("unexpected frames; got %q want %q", got, frames)
+		}
+	}
+}
+
+func TestReadFrameTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	WriteFrame(&buf, []byte(strings.Repeat("x", 300)))
+	data := buf.Bytes()
+	for _, n := range []int{1, 2, 10, len(data) - 1} {
+		_, err := ReadFrame(&sliceReader[byte]{items: data[:n]})
+		if err != ErrUnexpectedEOF {
+			t.Errorf("ReadFrame on %d of %d bytes returned %v; want %v", n, len(data), err, ErrUnexpectedEOF)
+		}
+	}
+}
+
+func TestReadFrameTooLong(t *testing.T) {
+	// A corrupt prefix encoding 1<<62.
+	prefix := binary.AppendUvarint(nil, 1<<62)
+	if _, err := ReadFrame(bytes.NewReader(prefix)); err != ErrFrameTooLong {
+		t.Errorf("unexpected error for huge prefix; got %v want %v", err, ErrFrameTooLong)
+	}
+	// A prefix that's too long to be a valid varint.
+	corrupt := bytes.Repeat([]byte{0xff}, 11)
+	if _, err := ReadFrame(bytes.NewReader(corrupt)); err == nil {
+		t.Errorf("expected error for corrupt prefix")
+	}
+
+	var buf bytes.Buffer
+	WriteFrame(&buf, []byte("hello world"))
+	if _, err := ReadFrameMax(bytes.NewReader(buf.Bytes()), 10); err != ErrFrameTooLong {
+		t.Errorf("unexpected error for frame over limit; got %v want %v", err, ErrFrameTooLong)
+	}
+	f, err := ReadFrameMax(bytes.NewReader(buf.Bytes()), 11)
+	if err != nil || string(f) != "hello world" {
+		t.Errorf("unexpected result for frame at limit; got %q, %v", f, err)
+	}
+}
+
+func TestReadFrameTruncatedLarge(t *testing.T) {
+	// The prefix claims a frame within the limit but much larger
+	// than the data that follows it; ReadFrame should not allocate
+	// the whole frame up front and should report the truncation.
+	data := binary.AppendUvarint(nil, DefaultMaxFrameSize)
+	data = append(data, "some data"...)
+	if _, err := ReadFrame(bytes.NewReader(data)); err != ErrUnexpectedEOF {
+		t.Errorf("unexpected error; got %v want %v", err, ErrUnexpectedEOF)
+	}
+	var m0, m1 runtime.MemStats
+	runtime.ReadMemStats(&m0)
+	ReadFrame(bytes.NewReader(data))
+	runtime.ReadMemStats(&m1)
+	if n := m1.TotalAlloc - m0.TotalAlloc; n > 1<<20 {
+		t.Errorf("too much memory allocated: %d bytes", n)
+	}
+}
+
+func TestReadFrameMultiChunk(t *testing.T) {
+	want := bytes.Repeat([]byte("0123456789"), frameReadSize/5)
+	var buf bytes.Buffer
+	WriteFrame(&buf, want)
+	got, err := ReadFrame(&sliceReader[byte]{items: buf.Bytes()})
+	if err != nil || !bytes.Equal(got, want) {
+		t.Errorf("unexpected result; got %d bytes, %v want %d bytes", len(got), err, len(want))
+	}
+}
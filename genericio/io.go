@@ -292,6 +292,28 @@ func ReadFull[T any](r Reader[T], buf []T) (n int, err error) {
 	return ReadAtLeast(r, buf, len(buf))
 }
 
+// WriteAll writes all of data to w, calling Write repeatedly
+// if w accepts fewer elements than requested without returning
+// an error. It returns the number of elements written and
+// the first error encountered, if any.
+// On return, n == len(data) if and only if err == nil.
+// If a call to Write makes no progress and returns no error,
+// WriteAll returns ErrShortWrite.
+func WriteAll[T any](w Writer[T], data []T) (n int, err error) {
+	for n < len(data) {
+		var nn int
+		nn, err = w.Write(data[n:])
+		n += nn
+		if err != nil {
+			return n, err
+		}
+		if nn == 0 {
+			return n, ErrShortWrite
+		}
+	}
+	return n, nil
+}
+
 // CopyN copies n bytes (or until an error) from src to dst.
 // It returns the number of bytes copied and the earliest
 // error encountered while copying.
@@ -506,3 +528,30 @@ func (t *teeReader[T]) Read(p []T) (n int, err error) {
 	}
 	return
 }
+
+// ReadWriteCloser3 returns a ReadWriteCloser that reads from r,
+// writes to w and closes c.
+// This makes it possible to present a pair of unidirectional
+// streams as a single bidirectional one.
+func ReadWriteCloser3[T any](r Reader[T], w Writer[T], c Closer) ReadWriteCloser[T] {
+	return readWriteCloser[T]{r, w, c}
+}
+
+// NopReadWriteCloser is like ReadWriteCloser3 except that
+// the Close method of the returned ReadWriteCloser does nothing
+// and returns nil.
+func NopReadWriteCloser[T any](r Reader[T], w Writer[T]) ReadWriteCloser[T] {
+	return ReadWriteCloser3(r, w, nopCloser{})
+}
+
+type readWriteCloser[T any] struct {
+	Reader[T]
+	Writer[T]
+	Closer
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -323,6 +323,34 @@ func testReadAtLeast(t *testing.T, rb ReadWriter[byte]) {
 	}
 }
 
+func TestWriteAll(t *testing.T) {
+	var written []byte
+	oneAtATime := writerFunc(func(p []byte) (int, error) {
+		written = append(written, p[0])
+		return 1, nil
+	})
+	n, err := WriteAll[byte](oneAtATime, []byte("hello"))
+	if n != 5 || err != nil {
+		t.Errorf("WriteAll = %v, %v; want 5, nil", n, err)
+	}
+	if string(written) != "hello" {
+		t.Errorf("wrote %q; want %q", written, "hello")
+	}
+
+	n, err = WriteAll[byte](&shortWriter{n: 3}, []byte("hello"))
+	if n != 3 || err != errShort {
+		t.Errorf("WriteAll = %v, %v; want 3, %v", n, err, errShort)
+	}
+
+	noProgress := writerFunc(func(p []byte) (int, error) {
+		return 0, nil
+	})
+	n, err = WriteAll[byte](noProgress, []byte("hello"))
+	if n != 0 || err != ErrShortWrite {
+		t.Errorf("WriteAll = %v, %v; want 0, %v", n, err, ErrShortWrite)
+	}
+}
+
 func TestTeeReader(t *testing.T) {
 	src := []byte("hello, world")
 	dst := make([]byte, len(src))
@@ -429,3 +457,47 @@ func TestSectionReader_Size(t *testing.T) {
 		}
 	}
 }
+
+func TestReadWriteCloser3(t *testing.T) {
+	var w bytes.Buffer
+	closeErr := errors.New("close error")
+	closed := 0
+	rwc := ReadWriteCloser3[byte](strings.NewReader("hello"), &w, closerFunc(func() error {
+		closed++
+		return closeErr
+	}))
+	buf := make([]byte, 10)
+	n, err := rwc.Read(buf)
+	if n != 5 || err != nil || string(buf[:n]) != "hello" {
+		t.Errorf("Read = %d, %v, %q; want 5, nil, %q", n, err, buf[:n], "hello")
+	}
+	n, err = rwc.Write([]byte("world"))
+	if n != 5 || err != nil || w.String() != "world" {
+		t.Errorf("Write = %d, %v, %q; want 5, nil, %q", n, err, w.String(), "world")
+	}
+	if err := rwc.Close(); err != closeErr || closed != 1 {
+		t.Errorf("Close = %v after %d calls; want %v after 1 call", err, closed, closeErr)
+	}
+}
+
+func TestNopReadWriteCloser(t *testing.T) {
+	var w bytes.Buffer
+	rwc := NopReadWriteCloser[byte](strings.NewReader("hello"), &w)
+	if err := rwc.Close(); err != nil {
+		t.Errorf("Close = %v; want nil", err)
+	}
+	// Close has no effect on the underlying reader and writer.
+	buf := make([]byte, 5)
+	if _, err := ReadFull(rwc, buf); err != nil || string(buf) != "hello" {
+		t.Errorf("ReadFull = %v, %q; want nil, %q", err, buf, "hello")
+	}
+	if _, err := rwc.Write([]byte("world")); err != nil || w.String() != "world" {
+		t.Errorf("Write = %v, %q; want nil, %q", err, w.String(), "world")
+	}
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
@@ -0,0 +1,84 @@
+package genericio
+
+// CopyItems copies items from src to dst one at a time until either
+// EOF is reached on src or an error occurs. It returns the number of
+// items copied and the first error encountered while copying, if any.
+//
+// A successful CopyItems returns err == nil, not err == EOF.
+// Because CopyItems is defined to read from src until EOF, it does
+// not treat an EOF from ReadItem as an error to be reported.
+func CopyItems[T any](dst ItemWriter[T], src ItemReader[T]) (written int64, err error) {
+	for {
+		x, err := src.ReadItem()
+		if err != nil {
+			if err == EOF {
+				err = nil
+			}
+			return written, err
+		}
+		if err := dst.WriteItem(x); err != nil {
+			return written, err
+		}
+		written++
+	}
+}
+
+// CopyItemsFromSlice writes each element of data to w in turn,
+// stopping at the first error. It returns the number of
+// elements written and the error, if any.
+func CopyItemsFromSlice[T any](w ItemWriter[T], data []T) (int, error) {
+	for i, x := range data {
+		if err := w.WriteItem(x); err != nil {
+			return i, err
+		}
+	}
+	return len(data), nil
+}
+
+// ReadItems reads items from r into buf until buf is full or
+// ReadItem returns an error. It returns the number of items read and
+// the error, if any. Unlike CopyItems, it returns EOF when it's
+// encountered, so on return, n == len(buf) if and only if err == nil.
+func ReadItems[T any](r ItemReader[T], buf []T) (n int, err error) {
+	for n < len(buf) {
+		buf[n], err = r.ReadItem()
+		if err != nil {
+			buf[n] = *new(T)
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+type roundRobinItemReader[T any] struct {
+	readers []ItemReader[T]
+	next    int
+}
+
+func (r *roundRobinItemReader[T]) ReadItem() (T, error) {
+	for len(r.readers) > 0 {
+		i := r.next % len(r.readers)
+		x, err := r.readers[i].ReadItem()
+		if err == EOF {
+			r.readers = append(r.readers[:i], r.readers[i+1:]...)
+			r.next = i
+			continue
+		}
+		r.next = i + 1
+		return x, err
+	}
+	return *new(T), EOF
+}
+
+// RoundRobinItemReader returns an ItemReader that interleaves items
+// from the provided readers: each call to ReadItem reads from the next
+// reader in turn, skipping readers that have returned EOF. Once all
+// the readers have returned EOF, ReadItem will return EOF. If any of
+// the readers returns a non-nil, non-EOF error, ReadItem will return
+// that error and move on to the next reader on the following call.
+func RoundRobinItemReader[T any](readers ...ItemReader[T]) ItemReader[T] {
+	r := make([]ItemReader[T], len(readers))
+	copy(r, readers)
+	return &roundRobinItemReader[T]{readers: r}
+}
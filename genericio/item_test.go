@@ -0,0 +1,126 @@
+package genericio
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sliceItemReader is an ItemReader that reads items from a slice.
+type sliceItemReader[T any] struct {
+	items []T
+}
+
+func (r *sliceItemReader[T]) ReadItem() (T, error) {
+	if len(r.items) == 0 {
+		return *new(T), EOF
+	}
+	x := r.items[0]
+	r.items = r.items[1:]
+	return x, nil
+}
+
+// sliceItemWriter is an ItemWriter that appends items to a slice.
+// If failAt is non-zero, the write of item number failAt
+// (counting from 1) fails with err.
+type sliceItemWriter[T any] struct {
+	items  []T
+	failAt int
+	err    error
+}
+
+func (w *sliceItemWriter[T]) WriteItem(x T) error {
+	if w.failAt > 0 && len(w.items)+1 == w.failAt {
+		return w.err
+	}
+	w.items = append(w.items, x)
+	return nil
+}
+
+func TestCopyItems(t *testing.T) {
+	src := &sliceItemReader[string]{items: []string{"a", "b", "c", "d"}}
+	dst := new(sliceItemWriter[string])
+	n, err := CopyItems[string](dst, src)
+	if err != nil {
+		t.Fatalf("CopyItems returned error: %v", err)
+	}
+	if n != 4 {
+		t.Errorf("CopyItems copied %d items; want 4", n)
+	}
+	if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(dst.items, want) {
+		t.Errorf("CopyItems wrote %q; want %q", dst.items, want)
+	}
+}
+
+func TestCopyItemsWriteError(t *testing.T) {
+	werr := errors.New("write error")
+	src := &sliceItemReader[int]{items: []int{1, 2, 3, 4}}
+	dst := &sliceItemWriter[int]{failAt: 3, err: werr}
+	n, err := CopyItems[int](dst, src)
+	if err != werr {
+		t.Errorf("CopyItems returned error %v; want %v", err, werr)
+	}
+	if n != 2 {
+		t.Errorf("CopyItems copied %d items; want 2", n)
+	}
+}
+
+func TestCopyItemsFromSlice(t *testing.T) {
+	w := new(sliceItemWriter[string])
+	n, err := CopyItemsFromSlice[string](w, []string{"a", "b", "c"})
+	if n != 3 || err != nil {
+		t.Errorf("CopyItemsFromSlice = %v, %v; want 3, nil", n, err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(w.items, want) {
+		t.Errorf("CopyItemsFromSlice wrote %q; want %q", w.items, want)
+	}
+
+	werr := errors.New("write error")
+	w = &sliceItemWriter[string]{failAt: 2, err: werr}
+	n, err = CopyItemsFromSlice[string](w, []string{"a", "b", "c"})
+	if n != 1 || err != werr {
+		t.Errorf("CopyItemsFromSlice = %v, %v; want 1, %v", n, err, werr)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(w.items, want) {
+		t.Errorf("CopyItemsFromSlice wrote %q; want %q", w.items, want)
+	}
+}
+
+func TestReadItems(t *testing.T) {
+	r := &sliceItemReader[int]{items: []int{1, 2, 3, 4, 5}}
+	buf := make([]int, 3)
+	n, err := ReadItems[int](r, buf)
+	if n != 3 || err != nil {
+		t.Errorf("ReadItems = %v, %v; want 3, nil", n, err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(buf, want) {
+		t.Errorf("ReadItems read %v; want %v", buf, want)
+	}
+	n, err = ReadItems[int](r, buf)
+	if n != 2 || err != EOF {
+		t.Errorf("ReadItems = %v, %v; want 2, EOF", n, err)
+	}
+	if want := []int{4, 5}; !reflect.DeepEqual(buf[:n], want) {
+		t.Errorf("ReadItems read %v; want %v", buf[:n], want)
+	}
+}
+
+func TestRoundRobinItemReader(t *testing.T) {
+	r := RoundRobinItemReader[string](
+		&sliceItemReader[string]{items: []string{"a1", "a2"}},
+		&sliceItemReader[string]{items: []string{"b1", "b2", "b3", "b4"}},
+		&sliceItemReader[string]{},
+		&sliceItemReader[string]{items: []string{"c1", "c2", "c3"}},
+	)
+	dst := new(sliceItemWriter[string])
+	if _, err := CopyItems(dst, r); err != nil {
+		t.Fatalf("CopyItems returned error: %v", err)
+	}
+	if want := []string{"a1", "b1", "c1", "a2", "b2", "c2", "b3", "c3", "b4"}; !reflect.DeepEqual(dst.items, want) {
+		t.Errorf("unexpected items; got %q want %q", dst.items, want)
+	}
+	// Subsequent reads continue to return EOF.
+	if _, err := r.ReadItem(); err != EOF {
+		t.Errorf("unexpected error after EOF; got %v want EOF", err)
+	}
+}
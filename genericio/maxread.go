@@ -0,0 +1,30 @@
+package genericio
+
+// MaxReadReader returns a Reader that reads from r but passes at most
+// maxChunk elements of the caller's buffer to each Read call on r, so
+// that no single Read returns more than maxChunk elements. It's useful
+// for bounding the amount of data handled per read and for testing
+// how code behaves at chunk boundaries.
+//
+// MaxReadReader panics if maxChunk is not positive.
+func MaxReadReader[T any](r Reader[T], maxChunk int) Reader[T] {
+	if maxChunk <= 0 {
+		panic("genericio.MaxReadReader called with non-positive maxChunk")
+	}
+	return &maxReadReader[T]{
+		r:        r,
+		maxChunk: maxChunk,
+	}
+}
+
+type maxReadReader[T any] struct {
+	r        Reader[T]
+	maxChunk int
+}
+
+func (r *maxReadReader[T]) Read(p []T) (int, error) {
+	if len(p) > r.maxChunk {
+		p = p[:r.maxChunk]
+	}
+	return r.r.Read(p)
+}
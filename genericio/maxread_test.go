@@ -0,0 +1,32 @@
+package genericio
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxReadReader(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+	r := MaxReadReader[int](&sliceReader[int]{items: items}, 3)
+	buf := make([]int, 100)
+	var got []int
+	for {
+		n, err := r.Read(buf)
+		if n > 3 {
+			t.Errorf("Read returned %d elements; want at most 3", n)
+		}
+		got = append(got, buf[:n]...)
+		if err == EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if !reflect.DeepEqual(got, items) {
+		t.Errorf("unexpected result; got %v want %v", got, items)
+	}
+}
@@ -51,6 +51,58 @@ func MultiReader[T any](readers ...Reader[T]) Reader[T] {
 	return &multiReader[T]{r}
 }
 
+type joinReader[T any] struct {
+	sep     T
+	readers []Reader[T]
+	needSep bool
+}
+
+func (jr *joinReader[T]) Read(p []T) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(jr.readers) > 0 {
+		if jr.needSep {
+			p[0] = jr.sep
+			p = p[1:]
+			n++
+			jr.needSep = false
+		}
+		var nr int
+		nr, err = jr.readers[0].Read(p)
+		n += nr
+		if err == EOF {
+			jr.readers[0] = eofReader[T]{} // permit earlier GC
+			jr.readers = jr.readers[1:]
+			jr.needSep = len(jr.readers) > 0
+			err = nil
+		}
+		if n > 0 || err != nil {
+			return
+		}
+	}
+	return 0, EOF
+}
+
+// JoinReader returns a Reader that's the logical concatenation of
+// the provided input readers with a single sep element inserted
+// between each adjacent pair. As with strings.Join, the rule is
+// purely positional: a separator is emitted between every pair of
+// adjacent readers even when one or both of them are empty, so
+// joining n readers always produces exactly n-1 separators.
+//
+// Once all inputs have returned EOF, Read will return EOF. If any of
+// the readers return a non-nil, non-EOF error, Read will return that
+// error.
+func JoinReader[T any](sep T, readers ...Reader[T]) Reader[T] {
+	r := make([]Reader[T], len(readers))
+	copy(r, readers)
+	return &joinReader[T]{
+		sep:     sep,
+		readers: r,
+	}
+}
+
 type multiWriter[T any] struct {
 	writers []Writer[T]
 }
@@ -63,6 +63,43 @@ func TestMultiReader(t *testing.T) {
 	})
 }
 
+func TestJoinReader(t *testing.T) {
+	tests := []struct {
+		readers []string
+		want    string
+	}{
+		{[]string{"foo", "bar", "baz"}, "foo,bar,baz"},
+		{[]string{"foo"}, "foo"},
+		{[]string{}, ""},
+		{[]string{"", "bar", ""}, ",bar,"},
+		{[]string{"foo", "", "baz"}, "foo,,baz"},
+	}
+	for _, test := range tests {
+		for _, bufSize := range []int{1, 2, 100} {
+			readers := make([]Reader[byte], len(test.readers))
+			for i, s := range test.readers {
+				readers[i] = strings.NewReader(s)
+			}
+			jr := JoinReader[byte](',', readers...)
+			var got []byte
+			buf := make([]byte, bufSize)
+			for {
+				n, err := jr.Read(buf)
+				got = append(got, buf[:n]...)
+				if err == EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if string(got) != test.want {
+				t.Errorf("JoinReader(%q) with buffer size %d; got %q want %q", test.readers, bufSize, got, test.want)
+			}
+		}
+	}
+}
+
 func TestMultiWriter(t *testing.T) {
 	sink := new(bytes.Buffer)
 	// Hide bytes.Buffer's WriteString method:
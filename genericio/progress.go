@@ -0,0 +1,28 @@
+package genericio
+
+// ProgressWriter returns a Writer that writes to w and calls report
+// with the total number of elements written so far after each
+// Write call that writes any elements. If a write fails part way
+// through, the total includes only the elements that were actually
+// written.
+func ProgressWriter[T any](w Writer[T], report func(total int64)) Writer[T] {
+	return &progressWriter[T]{
+		w:      w,
+		report: report,
+	}
+}
+
+type progressWriter[T any] struct {
+	w      Writer[T]
+	report func(total int64)
+	total  int64
+}
+
+func (pw *progressWriter[T]) Write(p []T) (n int, err error) {
+	n, err = pw.w.Write(p)
+	if n > 0 {
+		pw.total += int64(n)
+		pw.report(pw.total)
+	}
+	return n, err
+}
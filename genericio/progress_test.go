@@ -0,0 +1,73 @@
+package genericio
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestProgressWriter(t *testing.T) {
+	const data = "hello, world; this is some data"
+	var totals []int64
+	var buf bytes.Buffer
+	w := ProgressWriter[byte](&buf, func(total int64) {
+		totals = append(totals, total)
+	})
+	n, err := CopyBuffer(w, Reader[byte](strings.NewReader(data)), make([]byte, 5))
+	if err != nil {
+		t.Fatalf("CopyBuffer returned error: %v", err)
+	}
+	if n != int64(len(data)) || buf.String() != data {
+		t.Fatalf("unexpected copy result; got %d %q", n, buf.String())
+	}
+	if len(totals) != (len(data)+4)/5 {
+		t.Errorf("report called %d times; want %d", len(totals), (len(data)+4)/5)
+	}
+	for i := 1; i < len(totals); i++ {
+		if totals[i] <= totals[i-1] {
+			t.Errorf("totals not monotonically increasing: %v", totals)
+		}
+	}
+	if got := totals[len(totals)-1]; got != int64(len(data)) {
+		t.Errorf("final total %d; want %d", got, len(data))
+	}
+}
+
+// shortWriter is a Writer that writes at most n elements
+// before failing.
+type shortWriter struct {
+	n int
+}
+
+var errShort = errors.New("short writer full")
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+		return n, errShort
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestProgressWriterError(t *testing.T) {
+	var totals []int64
+	w := ProgressWriter[byte](&shortWriter{n: 7}, func(total int64) {
+		totals = append(totals, total)
+	})
+	if _, err := w.Write([]byte("hello")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n, err := w.Write([]byte("world")); n != 2 || err != errShort {
+		t.Fatalf("unexpected write result: %d, %v", n, err)
+	}
+	if _, err := w.Write([]byte("again")); err != errShort {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := totals, []int64{5, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected totals; got %v want %v", got, want)
+	}
+}
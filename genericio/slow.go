@@ -0,0 +1,62 @@
+package genericio
+
+import "time"
+
+// SlowReader returns a Reader that reads from r but sleeps for
+// delay before each Read call. It's intended for testing how code
+// behaves with slow input streams.
+func SlowReader[T any](r Reader[T], delay time.Duration) Reader[T] {
+	return &slowReader[T]{
+		r:     r,
+		delay: delay,
+	}
+}
+
+type slowReader[T any] struct {
+	r     Reader[T]
+	delay time.Duration
+}
+
+func (r *slowReader[T]) Read(p []T) (int, error) {
+	time.Sleep(r.delay)
+	return r.r.Read(p)
+}
+
+// RateLimitReader returns a Reader that reads from r but limits
+// the average rate at which elements are returned to
+// elementsPerSec, measured from the first Read call. After each Read,
+// it sleeps until enough time has elapsed for the total number of
+// elements read so far.
+//
+// Like SlowReader, it's intended for testing.
+//
+// It panics if elementsPerSec is not positive.
+func RateLimitReader[T any](r Reader[T], elementsPerSec float64) Reader[T] {
+	if !(elementsPerSec > 0) {
+		panic("genericio.RateLimitReader called with non-positive rate")
+	}
+	return &rateLimitReader[T]{
+		r:    r,
+		rate: elementsPerSec,
+	}
+}
+
+type rateLimitReader[T any] struct {
+	r     Reader[T]
+	rate  float64
+	start time.Time
+	total int64
+}
+
+func (r *rateLimitReader[T]) Read(p []T) (int, error) {
+	if r.start.IsZero() {
+		r.start = time.Now()
+	}
+	n, err := r.r.Read(p)
+	r.total += int64(n)
+	due := r.start.Add(time.Duration(float64(r.total) / r.rate * float64(time.Second)))
+	if d := time.Until(due); d > 0 {
+		time.Sleep(d)
+	}
+	return n, err
+}
@@ -0,0 +1,57 @@
+package genericio
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSlowReader(t *testing.T) {
+	const delay = 5 * time.Millisecond
+	r := SlowReader[byte](strings.NewReader("hello world"), delay)
+	var buf bytes.Buffer
+	t0 := time.Now()
+	// Four reads of data plus one that returns EOF.
+	n, err := CopyBuffer[byte](&buf, r, make([]byte, 3))
+	if err != nil {
+		t.Fatalf("CopyBuffer returned error: %v", err)
+	}
+	if n != 11 || buf.String() != "hello world" {
+		t.Fatalf("unexpected copy result; got %d %q", n, buf.String())
+	}
+	if got, want := time.Since(t0), 5*delay; got < want {
+		t.Errorf("copy took %v; want at least %v", got, want)
+	}
+}
+
+func TestRateLimitReader(t *testing.T) {
+	data := strings.Repeat("x", 50)
+	r := RateLimitReader[byte](strings.NewReader(data), 1000)
+	var buf bytes.Buffer
+	t0 := time.Now()
+	n, err := CopyBuffer[byte](&buf, r, make([]byte, 10))
+	if err != nil {
+		t.Fatalf("CopyBuffer returned error: %v", err)
+	}
+	if n != int64(len(data)) {
+		t.Fatalf("unexpected copy count %d", n)
+	}
+	if got, want := time.Since(t0), 50*time.Millisecond; got < want {
+		t.Errorf("copy took %v; want at least %v", got, want)
+	}
+}
+
+func TestRateLimitReaderBadRate(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("no panic for rate %v", rate)
+				}
+			}()
+			RateLimitReader[byte](strings.NewReader(""), rate)
+		}()
+	}
+}
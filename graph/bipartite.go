@@ -0,0 +1,64 @@
+package graph
+
+// Bipartite reports whether g is bipartite when its edges are
+// treated as undirected; that is, whether its nodes can be split
+// into two sets such that every edge connects a node in one set to a
+// node in the other.
+//
+// If it is, Bipartite returns the two sets, each in the same order as
+// g.AllNodes. Each connected component is colored independently, with
+// its first node (in g.AllNodes order) placed in partA. If g is not
+// bipartite, it returns nil, nil, false.
+func Bipartite[Node comparable, Edge any](g Graph[Node, Edge]) (partA, partB []Node, ok bool) {
+	color, ok := twoColor(g, neighbors(g))
+	if !ok {
+		return nil, nil, false
+	}
+	for _, n := range g.AllNodes() {
+		if color[n] {
+			partB = append(partB, n)
+		} else {
+			partA = append(partA, n)
+		}
+	}
+	return partA, partB, true
+}
+
+// twoColor attempts to color the nodes of g, with undirected
+// adjacency adj, with two colors (false and true) such that no two
+// adjacent nodes have the same color, using breadth-first search. It
+// reports whether it succeeded. A graph with a self-loop can never
+// be two-colored, but adj does not include self-loops, so g is
+// checked for them separately.
+func twoColor[Node comparable, Edge any](g Graph[Node, Edge], adj map[Node][]Node) (map[Node]bool, bool) {
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			if from, to := g.Nodes(e); from == n && to == n {
+				return nil, false
+			}
+		}
+	}
+	color := make(map[Node]bool)
+	var queue []Node
+	for _, n := range g.AllNodes() {
+		if _, ok := color[n]; ok {
+			continue
+		}
+		color[n] = false
+		queue = append(queue[:0], n)
+		for len(queue) > 0 {
+			m := queue[0]
+			queue = queue[1:]
+			for _, p := range adj[m] {
+				c, ok := color[p]
+				if !ok {
+					color[p] = !color[m]
+					queue = append(queue, p)
+				} else if c == color[m] {
+					return nil, false
+				}
+			}
+		}
+	}
+	return color, true
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBipartite(t *testing.T) {
+	// This is the graph:
+	// A-->B<--C   F
+	// |       |
+	// `-->D<--'
+	//     |
+	//     `-->E
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("A", "D")
+	g.AddEdge("C", "B")
+	g.AddEdge("C", "D")
+	g.AddEdge("D", "E")
+	g.AddNode("F")
+	partA, partB, ok := Bipartite(g.Graph())
+	if !ok {
+		t.Fatalf("graph unexpectedly not bipartite")
+	}
+	if want := []string{"A", "C", "E", "F"}; !reflect.DeepEqual(partA, want) {
+		t.Errorf("unexpected partA; got %v want %v", partA, want)
+	}
+	if want := []string{"B", "D"}; !reflect.DeepEqual(partB, want) {
+		t.Errorf("unexpected partB; got %v want %v", partB, want)
+	}
+}
+
+func TestBipartiteTriangle(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("A", "C")
+	partA, partB, ok := Bipartite(g.Graph())
+	if ok || partA != nil || partB != nil {
+		t.Errorf("triangle unexpectedly bipartite: %v %v", partA, partB)
+	}
+}
+
+func TestBipartiteSelfLoop(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "A")
+	partA, partB, ok := Bipartite(g.Graph())
+	if ok || partA != nil || partB != nil {
+		t.Errorf("graph with self-loop unexpectedly bipartite: %v %v", partA, partB)
+	}
+}
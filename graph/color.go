@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+)
+
+// GreedyColor returns a coloring of g, with its edges treated as
+// undirected, such that no two adjacent nodes have the same color.
+// Colors are numbered from zero.
+//
+// It uses the Welsh-Powell algorithm: nodes are colored in
+// descending order of degree, each with the smallest color not
+// used by any of its already-colored neighbors. Nodes of equal
+// degree are colored in g.AllNodes order, so the result is
+// deterministic given the same sequence of inputs.
+//
+// The coloring isn't necessarily optimal, but uses at most
+// one more color than the maximum degree of any node.
+func GreedyColor[Node comparable, Edge any](g Graph[Node, Edge]) map[Node]int {
+	adj := neighbors(g)
+	nodes := slices.Clone(g.AllNodes())
+	slices.SortStableFunc(nodes, func(n0, n1 Node) int {
+		return cmp.Compare(len(adj[n1]), len(adj[n0]))
+	})
+	colors := make(map[Node]int)
+	var used []bool
+	for _, n := range nodes {
+		clear(used)
+		for _, m := range adj[n] {
+			if c, ok := colors[m]; ok && c < len(used) {
+				used[c] = true
+			}
+		}
+		c := 0
+		for c < len(used) && used[c] {
+			c++
+		}
+		if c == len(used) {
+			used = append(used, false)
+		}
+		colors[n] = c
+	}
+	return colors
+}
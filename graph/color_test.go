@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGreedyColor(t *testing.T) {
+	g := new(Simple[string])
+	// A five-cycle, which needs three colors.
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("C", "D")
+	g.AddEdge("D", "E")
+	g.AddEdge("E", "A")
+	// A star, whose center should be colored first.
+	g.AddEdge("T", "S")
+	g.AddEdge("S", "U")
+	g.AddEdge("V", "S")
+	colors := GreedyColor(g.Graph())
+	want := map[string]int{
+		"A": 0,
+		"B": 1,
+		"C": 0,
+		"D": 1,
+		"E": 2,
+		"S": 0,
+		"T": 1,
+		"U": 1,
+		"V": 1,
+	}
+	if !reflect.DeepEqual(colors, want) {
+		t.Errorf("unexpected colors; got %v want %v", colors, want)
+	}
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			if colors[e[0]] == colors[e[1]] {
+				t.Errorf("adjacent nodes %s and %s have the same color", e[0], e[1])
+			}
+		}
+	}
+	for i := 0; i < 5; i++ {
+		if colors1 := GreedyColor(g.Graph()); !reflect.DeepEqual(colors1, colors) {
+			t.Fatalf("non-deterministic coloring; got %v want %v", colors1, colors)
+		}
+	}
+}
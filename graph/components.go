@@ -0,0 +1,22 @@
+package graph
+
+// ComponentCount returns the number of weakly connected components
+// in g, that is, the number of components when edges are treated as
+// undirected. Nodes without any edges count as components of their
+// own.
+func ComponentCount[Node comparable, Edge any](g Graph[Node, Edge]) int {
+	var t ConnectivityTracker[Node]
+	for _, n := range g.AllNodes() {
+		t.Add(n)
+	}
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n {
+				continue
+			}
+			t.Union(from, to)
+		}
+	}
+	return t.Components()
+}
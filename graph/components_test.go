@@ -0,0 +1,19 @@
+package graph
+
+import "testing"
+
+func TestComponentCount(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("C", "B")
+	g.AddEdge("B", "D")
+	g.AddEdge("E", "F")
+	g.AddEdge("F", "E")
+	g.AddNode("G")
+	if got, want := ComponentCount(g.Graph()), 3; got != want {
+		t.Errorf("unexpected component count; got %d want %d", got, want)
+	}
+	if got, want := ComponentCount(new(Simple[string]).Graph()), 0; got != want {
+		t.Errorf("unexpected component count for empty graph; got %d want %d", got, want)
+	}
+}
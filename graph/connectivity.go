@@ -0,0 +1,86 @@
+package graph
+
+// ConnectivityTracker tracks which nodes are connected to one another
+// as undirected edges are added. It is implemented as a union-find
+// structure with path compression and union by rank, so each
+// operation takes close to constant amortized time.
+//
+// Edges cannot be removed.
+//
+// The zero value is ready to use.
+type ConnectivityTracker[Node comparable] struct {
+	parent     map[Node]Node
+	rank       map[Node]int
+	components int
+}
+
+// Add adds n to the tracker as a component of its own if
+// it's not already known. Nodes are added implicitly by Union,
+// so this is only needed to count components with no edges.
+func (t *ConnectivityTracker[Node]) Add(n Node) {
+	if t.parent == nil {
+		t.parent = make(map[Node]Node)
+		t.rank = make(map[Node]int)
+	}
+	if _, ok := t.parent[n]; !ok {
+		t.parent[n] = n
+		t.components++
+	}
+}
+
+// Union records an edge between a and b, adding them
+// if necessary, so that they and everything connected
+// to them are in the same component.
+func (t *ConnectivityTracker[Node]) Union(a, b Node) {
+	t.Add(a)
+	t.Add(b)
+	ra, rb := t.find(a), t.find(b)
+	if ra == rb {
+		return
+	}
+	switch ka, kb := t.rank[ra], t.rank[rb]; {
+	case ka < kb:
+		t.parent[ra] = rb
+	case ka > kb:
+		t.parent[rb] = ra
+	default:
+		t.parent[rb] = ra
+		t.rank[ra]++
+	}
+	t.components--
+}
+
+// Connected reports whether there is a path between a and b.
+// A node is always connected to itself.
+func (t *ConnectivityTracker[Node]) Connected(a, b Node) bool {
+	if a == b {
+		return true
+	}
+	if _, ok := t.parent[a]; !ok {
+		return false
+	}
+	if _, ok := t.parent[b]; !ok {
+		return false
+	}
+	return t.find(a) == t.find(b)
+}
+
+// Components returns the number of connected components
+// amongst all the nodes that have been added.
+func (t *ConnectivityTracker[Node]) Components() int {
+	return t.components
+}
+
+// find returns the representative of the component
+// containing n, which must have been added,
+// compressing the path as it goes.
+func (t *ConnectivityTracker[Node]) find(n Node) Node {
+	root := n
+	for t.parent[root] != root {
+		root = t.parent[root]
+	}
+	for n != root {
+		n, t.parent[n] = t.parent[n], root
+	}
+	return root
+}
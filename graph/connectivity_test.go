@@ -0,0 +1,50 @@
+package graph
+
+import "testing"
+
+func TestConnectivityTracker(t *testing.T) {
+	var ct ConnectivityTracker[string]
+	if ct.Connected("a", "b") {
+		t.Errorf("unknown nodes are connected")
+	}
+	if !ct.Connected("a", "a") {
+		t.Errorf("node is not connected to itself")
+	}
+	if got := ct.Components(); got != 0 {
+		t.Errorf("unexpected initial component count; got %d want 0", got)
+	}
+	steps := []struct {
+		a, b        string
+		components  int
+		connectedAD bool
+		connectedDE bool
+	}{
+		{"a", "b", 1, false, false},
+		{"c", "d", 2, false, false},
+		{"e", "f", 3, false, false},
+		{"b", "c", 2, true, false},
+		// Adding an edge within a component changes nothing.
+		{"a", "d", 2, true, false},
+		{"f", "d", 1, true, true},
+	}
+	for i, step := range steps {
+		ct.Union(step.a, step.b)
+		if !ct.Connected(step.a, step.b) {
+			t.Errorf("step %d: %s and %s are not connected after Union", i, step.a, step.b)
+		}
+		if got := ct.Components(); got != step.components {
+			t.Errorf("step %d: unexpected component count; got %d want %d", i, got, step.components)
+		}
+		if got := ct.Connected("a", "d"); got != step.connectedAD {
+			t.Errorf("step %d: unexpected Connected(a, d); got %v want %v", i, got, step.connectedAD)
+		}
+		if got := ct.Connected("d", "e"); got != step.connectedDE {
+			t.Errorf("step %d: unexpected Connected(d, e); got %v want %v", i, got, step.connectedDE)
+		}
+	}
+	ct.Add("g")
+	ct.Add("g")
+	if got := ct.Components(); got != 2 {
+		t.Errorf("unexpected component count after Add; got %d want 2", got)
+	}
+}
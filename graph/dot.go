@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// NodeLabeler can be implemented by a Graph to provide
+// labels for its nodes. MarshalDOT uses it when available;
+// otherwise nodes are labeled with fmt.Sprint.
+type NodeLabeler[Node any] interface {
+	Label(n Node) string
+}
+
+// MarshalDOT returns a representation of g in the Graphviz DOT
+// language, suitable for passing to the dot command. Nodes appear
+// in g.AllNodes order and are given identifiers n0, n1, etc.,
+// with a label attribute holding the node's label.
+func MarshalDOT[Node comparable, Edge any](g Graph[Node, Edge]) ([]byte, error) {
+	label := func(n Node) string { return fmt.Sprint(n) }
+	if g, ok := g.(NodeLabeler[Node]); ok {
+		label = g.Label
+	}
+	var buf bytes.Buffer
+	buf.WriteString("digraph {\n")
+	ids := make(map[Node]int)
+	for i, n := range g.AllNodes() {
+		ids[n] = i
+		fmt.Fprintf(&buf, "\tn%d [label=%s];\n", i, dotQuote(label(n)))
+	}
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n {
+				continue
+			}
+			toID, ok := ids[to]
+			if !ok {
+				return nil, fmt.Errorf("edge to node %v that is not in graph", to)
+			}
+			fmt.Fprintf(&buf, "\tn%d -> n%d;\n", ids[from], toID)
+		}
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes(), nil
+}
+
+var dotReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+)
+
+// dotQuote returns s as a DOT quoted string. Backslashes are
+// escaped so that they're not interpreted as escape sequences
+// in labels.
+func dotQuote(s string) string {
+	return `"` + dotReplacer.Replace(s) + `"`
+}
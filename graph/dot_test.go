@@ -0,0 +1,93 @@
+package graph
+
+import "testing"
+
+var marshalDOTTests = []struct {
+	testName string
+	graph    Graph[string, [2]string]
+	want     string
+}{{
+	testName: "empty",
+	graph:    new(Simple[string]),
+	want: `digraph {
+}
+`,
+}, {
+	testName: "single-node",
+	graph: func() Graph[string, [2]string] {
+		g := new(Simple[string])
+		g.AddNode("A")
+		return g
+	}(),
+	want: `digraph {
+	n0 [label="A"];
+}
+`,
+}, {
+	testName: "edges",
+	graph: func() Graph[string, [2]string] {
+		g := new(Simple[string])
+		g.AddEdge("A", "B")
+		g.AddEdge("A", "C")
+		g.AddEdge("C", "A")
+		return g
+	}(),
+	want: `digraph {
+	n0 [label="A"];
+	n1 [label="B"];
+	n2 [label="C"];
+	n0 -> n1;
+	n0 -> n2;
+	n2 -> n0;
+}
+`,
+}, {
+	testName: "escaping",
+	graph: func() Graph[string, [2]string] {
+		g := new(Simple[string])
+		g.AddEdge(`say "hello"`, "back\\slash\nnewline")
+		return g
+	}(),
+	want: `digraph {
+	n0 [label="say \"hello\""];
+	n1 [label="back\\slash\nnewline"];
+	n0 -> n1;
+}
+`,
+}, {
+	testName: "labeler",
+	graph: func() Graph[string, [2]string] {
+		g := &labelGraph{}
+		g.AddEdge("A", "B")
+		return g
+	}(),
+	want: `digraph {
+	n0 [label="node A"];
+	n1 [label="node B"];
+	n0 -> n1;
+}
+`,
+}}
+
+func TestMarshalDOT(t *testing.T) {
+	for _, test := range marshalDOTTests {
+		t.Run(test.testName, func(t *testing.T) {
+			got, err := MarshalDOT(test.graph)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != test.want {
+				t.Errorf("unexpected result; got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+// labelGraph implements NodeLabeler as well as Graph.
+type labelGraph struct {
+	Simple[string]
+}
+
+func (g *labelGraph) Label(n string) string {
+	return "node " + n
+}
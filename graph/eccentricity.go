@@ -0,0 +1,43 @@
+package graph
+
+// Eccentricities returns the eccentricity of each node in g, with its
+// edges treated as undirected: the greatest number of edges on the
+// shortest path from that node to any other node. It also returns the
+// diameter of g, the greatest eccentricity.
+//
+// If g is not connected, the eccentricities are infinite, so it
+// returns nil, 0, false.
+//
+// It performs a breadth-first search from every node, so it takes
+// O(V·(V+E)) time.
+func Eccentricities[Node comparable, Edge any](g Graph[Node, Edge]) (ecc map[Node]int, diameter int, ok bool) {
+	adj := neighbors(g)
+	nodes := g.AllNodes()
+	ecc = make(map[Node]int)
+	dist := make(map[Node]int)
+	var queue []Node
+	for _, n := range nodes {
+		clear(dist)
+		dist[n] = 0
+		queue = append(queue[:0], n)
+		maxDist := 0
+		for len(queue) > 0 {
+			m := queue[0]
+			queue = queue[1:]
+			d := dist[m]
+			maxDist = max(maxDist, d)
+			for _, p := range adj[m] {
+				if _, ok := dist[p]; !ok {
+					dist[p] = d + 1
+					queue = append(queue, p)
+				}
+			}
+		}
+		if len(dist) != len(nodes) {
+			return nil, 0, false
+		}
+		ecc[n] = maxDist
+		diameter = max(diameter, maxDist)
+	}
+	return ecc, diameter, true
+}
@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEccentricities(t *testing.T) {
+	// A path graph 0-1-2-3-4 with edges in both directions
+	// for some of the nodes.
+	g := new(Simple[int])
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 1)
+	g.AddEdge(3, 2)
+	g.AddEdge(3, 4)
+	ecc, diameter, ok := Eccentricities(g.Graph())
+	if !ok {
+		t.Fatalf("graph unexpectedly disconnected")
+	}
+	if diameter != 4 {
+		t.Errorf("unexpected diameter; got %d want 4", diameter)
+	}
+	if want := map[int]int{0: 4, 1: 3, 2: 2, 3: 3, 4: 4}; !reflect.DeepEqual(ecc, want) {
+		t.Errorf("unexpected eccentricities; got %v want %v", ecc, want)
+	}
+
+	g.AddNode(5)
+	if ecc, diameter, ok := Eccentricities(g.Graph()); ok || ecc != nil || diameter != 0 {
+		t.Errorf("unexpected result for disconnected graph: %v, %v, %v", ecc, diameter, ok)
+	}
+}
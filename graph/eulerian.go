@@ -0,0 +1,101 @@
+package graph
+
+import "slices"
+
+// EulerianPath returns a path through g that traverses every edge
+// exactly once, using Hierholzer's algorithm. If every node has equal
+// in-degree and out-degree, the path is a circuit that starts and
+// ends at the first node in g.AllNodes with any outgoing edges.
+//
+// It reports false if there is no such path, which is the case
+// unless all the nodes have equal in-degree and out-degree except
+// possibly for one start node with one more outgoing than incoming
+// edge and one end node with one more incoming than outgoing edge,
+// and all the edges are reachable from the start node.
+func EulerianPath[Node comparable, Edge any](g Graph[Node, Edge]) ([]Edge, bool) {
+	in, out := degrees(g)
+	var start, end []Node
+	var first Node
+	nedges := 0
+	for _, n := range g.AllNodes() {
+		switch d := out[n] - in[n]; {
+		case d == 1:
+			start = append(start, n)
+		case d == -1:
+			end = append(end, n)
+		case d != 0:
+			return nil, false
+		}
+		if out[n] > 0 && nedges == 0 {
+			first = n
+		}
+		nedges += out[n]
+	}
+	if nedges == 0 {
+		return nil, true
+	}
+	switch {
+	case len(start) == 1 && len(end) == 1:
+		first = start[0]
+	case len(start) != 0 || len(end) != 0:
+		return nil, false
+	}
+
+	type frame struct {
+		n Node
+		// e holds the edge that we traversed to arrive at n.
+		e Edge
+	}
+	// outgoing holds the outgoing edges of each node and
+	// next holds the index of the next unused one.
+	outgoing := make(map[Node][]Edge)
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			if from, _ := g.Nodes(e); from == n {
+				outgoing[n] = append(outgoing[n], e)
+			}
+		}
+	}
+	next := make(map[Node]int)
+	stack := []frame{{n: first}}
+	path := make([]Edge, 0, nedges)
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		if i := next[f.n]; i < len(outgoing[f.n]) {
+			next[f.n]++
+			e := outgoing[f.n][i]
+			_, to := g.Nodes(e)
+			stack = append(stack, frame{to, e})
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		if len(stack) > 0 {
+			path = append(path, f.e)
+		}
+	}
+	if len(path) != nedges {
+		// Some edges weren't reachable from the start node.
+		return nil, false
+	}
+	slices.Reverse(path)
+	return path, true
+}
+
+// degrees returns the in-degree and out-degree of each node in g
+// that has any edges. As with neighbors, only edges returned by
+// g.Edges(n) with n as their source are considered.
+func degrees[Node comparable, Edge any](g Graph[Node, Edge]) (in, out map[Node]int) {
+	in = make(map[Node]int)
+	out = make(map[Node]int)
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n {
+				continue
+			}
+			out[from]++
+			in[to]++
+		}
+	}
+	return in, out
+}
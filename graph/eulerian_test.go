@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+var eulerianPathTests = []struct {
+	testName string
+	edges    [][2]string
+	want     [][2]string
+	wantOK   bool
+}{{
+	testName: "circuit",
+	edges: [][2]string{
+		{"A", "B"}, {"B", "C"}, {"C", "A"},
+		{"C", "D"}, {"D", "E"}, {"E", "C"},
+	},
+	want: [][2]string{
+		{"A", "B"}, {"B", "C"}, {"C", "D"},
+		{"D", "E"}, {"E", "C"}, {"C", "A"},
+	},
+	wantOK: true,
+}, {
+	testName: "path",
+	edges: [][2]string{
+		{"A", "B"}, {"B", "C"}, {"C", "B"}, {"B", "D"},
+	},
+	want: [][2]string{
+		{"A", "B"}, {"B", "C"}, {"C", "B"}, {"B", "D"},
+	},
+	wantOK: true,
+}, {
+	testName: "path-not-starting-at-first-node",
+	edges: [][2]string{
+		{"B", "C"}, {"A", "B"},
+	},
+	want: [][2]string{
+		{"A", "B"}, {"B", "C"},
+	},
+	wantOK: true,
+}, {
+	testName: "unbalanced",
+	edges: [][2]string{
+		{"A", "B"}, {"A", "C"}, {"A", "D"},
+	},
+}, {
+	testName: "disconnected",
+	edges: [][2]string{
+		{"A", "B"}, {"B", "A"}, {"C", "D"}, {"D", "C"},
+	},
+}, {
+	testName: "empty",
+	wantOK:   true,
+}}
+
+func TestEulerianPath(t *testing.T) {
+	for _, test := range eulerianPathTests {
+		t.Run(test.testName, func(t *testing.T) {
+			g := new(Simple[string])
+			for _, e := range test.edges {
+				g.AddEdge(e[0], e[1])
+			}
+			path, ok := EulerianPath(g.Graph())
+			if ok != test.wantOK {
+				t.Fatalf("unexpected ok; got %v want %v", ok, test.wantOK)
+			}
+			if !reflect.DeepEqual(path, test.want) {
+				t.Errorf("unexpected path; got %v want %v", path, test.want)
+			}
+		})
+	}
+}
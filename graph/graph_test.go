@@ -70,6 +70,37 @@ func testShortestPath(t *testing.T, test graphTest) {
 	}
 }
 
+func TestShortestPathWithLengths(t *testing.T) {
+	g := &lengthGraph{
+		lengths: map[[2]int]int{
+			{1, 4}: 10,
+			{1, 2}: 1,
+			{2, 3}: 2,
+			{3, 4}: 3,
+		},
+	}
+	for e := range g.lengths {
+		g.AddEdge(e[0], e[1])
+	}
+	if got, want := ShortestPath(g.Simple.Graph(), 1, 4), [][2]int{{1, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected result without lengths; got %v want %v", got, want)
+	}
+	got := ShortestPath[int, [2]int](g, 1, 4)
+	if want := [][2]int{{1, 2}, {2, 3}, {3, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected result with lengths; got %v want %v", got, want)
+	}
+}
+
+// lengthGraph implements EdgeLengther as well as Graph.
+type lengthGraph struct {
+	Simple[int]
+	lengths map[[2]int]int
+}
+
+func (g *lengthGraph) Length(e [2]int) int {
+	return g.lengths[e]
+}
+
 func newGraph(edges [][2]int) Graph[int, [2]int] {
 	var g Simple[int]
 	for _, e := range edges {
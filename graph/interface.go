@@ -5,3 +5,11 @@ type Graph[Node comparable, Edge any] interface {
 	Nodes(e Edge) (from, to Node)
 	AllNodes() []Node
 }
+
+// EdgeLengther can be implemented by a Graph to give its
+// edges a length. ShortestPath uses it when available; otherwise
+// all edges are treated as having length 1. Lengths must not be
+// negative.
+type EdgeLengther[Edge any] interface {
+	Length(e Edge) int
+}
@@ -0,0 +1,65 @@
+package graph
+
+import "github.com/rogpeppe/generic/heap"
+
+// coreItem holds a node in the heap used by Coreness.
+type coreItem[Node any] struct {
+	n     Node
+	deg   int
+	index int
+}
+
+// Coreness returns the core number of each node in g, treating
+// edges as undirected. The core number of a node is the largest k
+// such that the node belongs to the k-core of g (see KCore).
+//
+// It works by repeatedly removing the node with the smallest
+// remaining degree.
+func Coreness[Node comparable, Edge any](g Graph[Node, Edge]) map[Node]int {
+	adj := neighbors(g)
+	items := make(map[Node]*coreItem[Node], len(adj))
+	h := heap.New(nil, func(i1, i2 *coreItem[Node]) bool {
+		return i1.deg < i2.deg
+	}, func(it **coreItem[Node], i int) {
+		(*it).index = i
+	})
+	for n, ns := range adj {
+		it := &coreItem[Node]{
+			n:   n,
+			deg: len(ns),
+		}
+		items[n] = it
+		h.Push(it)
+	}
+	core := make(map[Node]int, len(adj))
+	k := 0
+	for h.Len() > 0 {
+		it := h.Pop()
+		k = max(k, it.deg)
+		core[it.n] = k
+		for _, m := range adj[it.n] {
+			if _, ok := core[m]; ok {
+				continue
+			}
+			mit := items[m]
+			mit.deg--
+			h.Fix(mit.index)
+		}
+	}
+	return core
+}
+
+// KCore returns the nodes in the k-core of g, the maximal subgraph
+// in which every node has degree at least k, treating edges as
+// undirected. The nodes are returned in the same order as
+// g.AllNodes.
+func KCore[Node comparable, Edge any](g Graph[Node, Edge], k int) []Node {
+	core := Coreness(g)
+	var nodes []Node
+	for _, n := range g.AllNodes() {
+		if core[n] >= k {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKCore(t *testing.T) {
+	// This is the graph:
+	// A---B   E
+	// |\  |
+	// | `-C
+	// D
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("C", "A")
+	g.AddEdge("A", "D")
+	g.AddNode("E")
+
+	if got, want := KCore(g.Graph(), 2), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected 2-core; got %v want %v", got, want)
+	}
+	if got, want := KCore(g.Graph(), 1), []string{"A", "B", "C", "D"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected 1-core; got %v want %v", got, want)
+	}
+	if got := KCore(g.Graph(), 3); len(got) != 0 {
+		t.Errorf("unexpected 3-core; got %v want []", got)
+	}
+	want := map[string]int{
+		"A": 2,
+		"B": 2,
+		"C": 2,
+		"D": 1,
+		"E": 0,
+	}
+	if got := Coreness(g.Graph()); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected coreness; got %v want %v", got, want)
+	}
+}
@@ -0,0 +1,35 @@
+package graph
+
+// Layers assigns each node in g to a layer according to the
+// smallest number of edges on a path to it from any source node
+// (a node with no incoming edges). Source nodes are in layer 0.
+// This is useful for ranking nodes when laying out a graph.
+//
+// Nodes that cannot be reached from any source, which is only
+// possible when g contains cycles, are omitted from the result.
+func Layers[Node comparable, Edge any](g Graph[Node, Edge]) map[Node]int {
+	in, _ := degrees(g)
+	layers := make(map[Node]int)
+	var queue []Node
+	for _, n := range g.AllNodes() {
+		if in[n] == 0 {
+			layers[n] = 0
+			queue = append(queue, n)
+		}
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n {
+				continue
+			}
+			if _, ok := layers[to]; !ok {
+				layers[to] = layers[n] + 1
+				queue = append(queue, to)
+			}
+		}
+	}
+	return layers
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLayers(t *testing.T) {
+	g := new(Simple[string])
+	// Two sources, a and b, with c reachable from both
+	// at different distances.
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("d", "c")
+	g.AddEdge("c", "e")
+	g.AddEdge("d", "e")
+	// A separate component.
+	g.AddEdge("x", "y")
+	// An isolated node.
+	g.AddNode("z")
+	// A cycle that's not reachable from any source.
+	g.AddEdge("p", "q")
+	g.AddEdge("q", "p")
+	want := map[string]int{
+		"a": 0,
+		"b": 0,
+		"c": 1,
+		"d": 1,
+		"e": 2,
+		"x": 0,
+		"y": 1,
+		"z": 0,
+	}
+	if got := Layers(g.Graph()); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected layers; got %v want %v", got, want)
+	}
+}
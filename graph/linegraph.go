@@ -0,0 +1,29 @@
+package graph
+
+// LineGraph returns the directed line graph of g. Each edge in g
+// becomes a node in the line graph, and there's an edge from e1 to e2
+// in the line graph when the destination of e1 is the source of e2.
+//
+// The nodes of the line graph are ordered as the edges are
+// encountered when traversing g.AllNodes and g.Edges.
+func LineGraph[Node comparable, Edge comparable](g Graph[Node, Edge]) *Simple[Edge] {
+	lg := new(Simple[Edge])
+	outgoing := make(map[Node][]Edge)
+	for _, n := range g.AllNodes() {
+		for _, e := range g.Edges(n) {
+			if from, _ := g.Nodes(e); from == n {
+				outgoing[n] = append(outgoing[n], e)
+				lg.AddNode(e)
+			}
+		}
+	}
+	for _, n := range g.AllNodes() {
+		for _, e1 := range outgoing[n] {
+			_, to := g.Nodes(e1)
+			for _, e2 := range outgoing[to] {
+				lg.AddEdge(e1, e2)
+			}
+		}
+	}
+	return lg
+}
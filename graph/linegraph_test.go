@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLineGraph(t *testing.T) {
+	// A-->B-->C
+	// ^   |
+	// |   v
+	// `---D
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("B", "D")
+	g.AddEdge("D", "A")
+	lg := LineGraph(g.Graph())
+	type edge = [2]string
+	wantNodes := []edge{{"A", "B"}, {"B", "C"}, {"B", "D"}, {"D", "A"}}
+	if got := lg.AllNodes(); !reflect.DeepEqual(got, wantNodes) {
+		t.Errorf("unexpected nodes; got %v want %v", got, wantNodes)
+	}
+	wantEdges := map[edge][]edge{
+		{"A", "B"}: {{"B", "C"}, {"B", "D"}},
+		{"B", "D"}: {{"D", "A"}},
+		{"D", "A"}: {{"A", "B"}},
+	}
+	for _, n := range lg.AllNodes() {
+		var got []edge
+		for _, e := range lg.Edges(n) {
+			_, to := lg.Nodes(e)
+			got = append(got, to)
+		}
+		if want := wantEdges[n]; !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected successors of %v; got %v want %v", n, got, want)
+		}
+	}
+}
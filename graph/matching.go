@@ -0,0 +1,68 @@
+package graph
+
+// MaxBipartiteMatching returns a maximum matching of g with its edges
+// treated as undirected: a largest set of edges, no two of which share
+// a node. It uses the augmenting-path algorithm.
+//
+// Each match is returned as a pair of nodes, the first from the
+// partA set returned by Bipartite and the second from partB.
+// The matches are ordered by the first node, in g.AllNodes order.
+//
+// If g is not bipartite, it returns nil, false.
+func MaxBipartiteMatching[Node comparable, Edge any](g Graph[Node, Edge]) (matches [][2]Node, ok bool) {
+	adj := neighbors(g)
+	color, ok := twoColor(g, adj)
+	if !ok {
+		return nil, false
+	}
+	m := &matcher[Node]{
+		adj:   adj,
+		match: make(map[Node]Node),
+	}
+	var partA []Node
+	for _, n := range g.AllNodes() {
+		if !color[n] {
+			partA = append(partA, n)
+			m.visited = make(map[Node]bool)
+			m.augment(n)
+		}
+	}
+	// Invert the matching so we can produce it in partA order.
+	matchA := make(map[Node]Node, len(m.match))
+	for b, a := range m.match {
+		matchA[a] = b
+	}
+	for _, a := range partA {
+		if b, ok := matchA[a]; ok {
+			matches = append(matches, [2]Node{a, b})
+		}
+	}
+	return matches, true
+}
+
+type matcher[Node comparable] struct {
+	adj map[Node][]Node
+	// match maps from each matched node in partB to
+	// the node in partA that it's matched with.
+	match map[Node]Node
+	// visited holds the partB nodes visited
+	// by the current augmenting path search.
+	visited map[Node]bool
+}
+
+// augment tries to find an augmenting path starting at the
+// partA node a, and updates the matching if one is found.
+// It reports whether it succeeded.
+func (m *matcher[Node]) augment(a Node) bool {
+	for _, b := range m.adj[a] {
+		if m.visited[b] {
+			continue
+		}
+		m.visited[b] = true
+		if a1, ok := m.match[b]; !ok || m.augment(a1) {
+			m.match[b] = a
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxBipartiteMatching(t *testing.T) {
+	// Workers A, B, C, D can do jobs as follows:
+	//	A: 1, 2
+	//	B: 1
+	//	C: 2, 3, 4
+	//	D: 3
+	// A greedy assignment of A to 1 prevents B from
+	// being matched, so the algorithm must find
+	// an augmenting path.
+	g := new(Simple[string])
+	g.AddEdge("A", "1")
+	g.AddEdge("A", "2")
+	g.AddEdge("B", "1")
+	g.AddEdge("C", "2")
+	g.AddEdge("C", "3")
+	g.AddEdge("C", "4")
+	g.AddEdge("D", "3")
+	matches, ok := MaxBipartiteMatching(g.Graph())
+	if !ok {
+		t.Fatalf("graph unexpectedly not bipartite")
+	}
+	want := [][2]string{{"A", "2"}, {"B", "1"}, {"C", "4"}, {"D", "3"}}
+	if !reflect.DeepEqual(matches, want) {
+		t.Errorf("unexpected matches; got %v want %v", matches, want)
+	}
+	matched := make(map[string]bool)
+	for _, m := range matches {
+		for _, n := range m {
+			if matched[n] {
+				t.Errorf("node %q matched twice", n)
+			}
+			matched[n] = true
+		}
+		if !hasEdge(g, m[0], m[1]) && !hasEdge(g, m[1], m[0]) {
+			t.Errorf("match %v is not an edge", m)
+		}
+	}
+}
+
+func TestMaxBipartiteMatchingNotBipartite(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("C", "A")
+	matches, ok := MaxBipartiteMatching(g.Graph())
+	if ok || matches != nil {
+		t.Errorf("unexpected result for non-bipartite graph: %v, %v", matches, ok)
+	}
+}
+
+func TestMaxBipartiteMatchingSelfLoop(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "1")
+	g.AddEdge("B", "B")
+	matches, ok := MaxBipartiteMatching(g.Graph())
+	if ok || matches != nil {
+		t.Errorf("unexpected result for graph with self-loop: %v, %v", matches, ok)
+	}
+}
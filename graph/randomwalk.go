@@ -0,0 +1,31 @@
+package graph
+
+import "math/rand/v2"
+
+// RandomWalk returns a random walk through g of at most steps hops,
+// starting at start. At each step, it follows one of the outgoing
+// edges of the current node, chosen uniformly at random using rng.
+// The walk stops early if it reaches a node with no outgoing edges.
+//
+// The returned slice holds all the nodes visited, including start,
+// so it holds at most steps+1 nodes. Using an rng with a fixed
+// seed makes the walk reproducible.
+func RandomWalk[Node comparable, Edge any](g Graph[Node, Edge], start Node, steps int, rng *rand.Rand) []Node {
+	walk := []Node{start}
+	var out []Edge
+	n := start
+	for range steps {
+		out = out[:0]
+		for _, e := range g.Edges(n) {
+			if from, _ := g.Nodes(e); from == n {
+				out = append(out, e)
+			}
+		}
+		if len(out) == 0 {
+			break
+		}
+		_, n = g.Nodes(out[rng.IntN(len(out))])
+		walk = append(walk, n)
+	}
+	return walk
+}
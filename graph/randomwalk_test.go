@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func TestRandomWalk(t *testing.T) {
+	// This is the graph:
+	// ,---------.
+	// v         |
+	// A-->B-->C-'
+	//     |
+	//     `-->D-->E
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("C", "A")
+	g.AddEdge("B", "D")
+	g.AddEdge("D", "E")
+
+	walk := RandomWalk(g.Graph(), "A", 20, rand.New(rand.NewPCG(1, 2)))
+	if len(walk) == 0 || walk[0] != "A" {
+		t.Fatalf("walk does not start at A: %v", walk)
+	}
+	for i := 1; i < len(walk); i++ {
+		if !hasEdge(g, walk[i-1], walk[i]) {
+			t.Fatalf("walk %v takes non-existent edge %s -> %s", walk, walk[i-1], walk[i])
+		}
+	}
+	if len(walk) < 21 && walk[len(walk)-1] != "E" {
+		t.Errorf("walk %v stopped early at a node that isn't a sink", walk)
+	}
+	walk1 := RandomWalk(g.Graph(), "A", 20, rand.New(rand.NewPCG(1, 2)))
+	if !reflect.DeepEqual(walk, walk1) {
+		t.Errorf("walk with the same seed is not deterministic; got %v then %v", walk, walk1)
+	}
+}
+
+func TestRandomWalkStopsAtSink(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	walk := RandomWalk(g.Graph(), "A", 10, rand.New(rand.NewPCG(1, 2)))
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(walk, want) {
+		t.Errorf("unexpected walk; got %v want %v", walk, want)
+	}
+	walk = RandomWalk(g.Graph(), "A", 1, rand.New(rand.NewPCG(1, 2)))
+	if want := []string{"A", "B"}; !reflect.DeepEqual(walk, want) {
+		t.Errorf("unexpected walk; got %v want %v", walk, want)
+	}
+}
+
+func hasEdge[Node comparable](g *Simple[Node], from, to Node) bool {
+	for _, e := range g.Edges(from) {
+		if e == [2]Node{from, to} {
+			return true
+		}
+	}
+	return false
+}
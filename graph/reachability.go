@@ -0,0 +1,73 @@
+package graph
+
+import "fmt"
+
+// ReachabilityIndex answers reachability queries on a directed
+// acyclic graph in constant time. It holds the transitive
+// closure of the graph as a bitset for each node.
+type ReachabilityIndex[Node comparable] struct {
+	// index holds the index of each node in the bitsets.
+	index map[Node]int
+	// reach holds, for each node index, the set
+	// of node indexes reachable from that node.
+	reach [][]uint64
+}
+
+// NewReachabilityIndex returns a ReachabilityIndex for g,
+// which must be acyclic; it returns an error if it's not.
+//
+// Computing the index takes O(V·E/64) time and the index
+// uses O(V²/64) words of memory, where V is the number of
+// nodes and E is the number of edges, so it's best suited to
+// graphs that are queried many times.
+//
+// The index reflects g at the time it was created; it does not
+// change when g does.
+func NewReachabilityIndex[Node comparable, Edge any](g Graph[Node, Edge]) (*ReachabilityIndex[Node], error) {
+	sorted, cycles := TopoSort(g)
+	if len(cycles) > 0 {
+		return nil, fmt.Errorf("graph has a cycle: %v", cycles[0])
+	}
+	ri := &ReachabilityIndex[Node]{
+		index: make(map[Node]int),
+		reach: make([][]uint64, len(sorted)),
+	}
+	for i, n := range sorted {
+		ri.index[n] = i
+	}
+	words := (len(sorted) + 63) / 64
+	// TopoSort returns the nodes with successors before
+	// their predecessors, so by the time we visit a node,
+	// the sets of all its successors are complete.
+	for i, n := range sorted {
+		set := make([]uint64, words)
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n {
+				continue
+			}
+			j := ri.index[to]
+			set[j/64] |= 1 << (j % 64)
+			for k, w := range ri.reach[j] {
+				set[k] |= w
+			}
+		}
+		ri.reach[i] = set
+	}
+	return ri, nil
+}
+
+// CanReach reports whether there's a path from a to b.
+// Every node in the graph can reach itself. It reports
+// false if either node isn't in the graph.
+func (ri *ReachabilityIndex[Node]) CanReach(a, b Node) bool {
+	i, ok := ri.index[a]
+	if !ok {
+		return false
+	}
+	j, ok := ri.index[b]
+	if !ok {
+		return false
+	}
+	return i == j || ri.reach[i][j/64]&(1<<(j%64)) != 0
+}
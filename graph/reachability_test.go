@@ -0,0 +1,59 @@
+package graph
+
+import "testing"
+
+func TestReachabilityIndex(t *testing.T) {
+	g := new(Simple[int])
+	// A DAG with more than 64 nodes so that the
+	// bitsets span several words: a chain 0->1->...->69,
+	// with some extra nodes hanging off it.
+	for i := 0; i < 69; i++ {
+		g.AddEdge(i, i+1)
+	}
+	g.AddEdge(3, 100)
+	g.AddEdge(100, 101)
+	g.AddEdge(65, 101)
+	g.AddEdge(102, 0)
+	g.AddNode(103)
+	ri, err := NewReachabilityIndex(g.Graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes := g.AllNodes()
+	for _, a := range nodes {
+		reachable := bfsReachable(g, a)
+		for _, b := range nodes {
+			if got, want := ri.CanReach(a, b), reachable[b]; got != want {
+				t.Errorf("CanReach(%d, %d) = %v; want %v", a, b, got, want)
+			}
+		}
+	}
+	if ri.CanReach(0, 1000) || ri.CanReach(1000, 0) {
+		t.Errorf("unexpected reachability for unknown node")
+	}
+}
+
+func TestReachabilityIndexCycle(t *testing.T) {
+	_, err := NewReachabilityIndex(multiCycleGraph().Graph())
+	if err == nil {
+		t.Fatalf("expected error for cyclic graph")
+	}
+}
+
+// bfsReachable returns the set of nodes reachable from n,
+// including n itself.
+func bfsReachable[Node comparable](g *Simple[Node], n Node) map[Node]bool {
+	reachable := map[Node]bool{n: true}
+	queue := []Node{n}
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		for _, e := range g.Edges(m) {
+			if !reachable[e[1]] {
+				reachable[e[1]] = true
+				queue = append(queue, e[1])
+			}
+		}
+	}
+	return reachable
+}
@@ -0,0 +1,120 @@
+package graph
+
+// TarjanSCC returns the strongly connected components of g
+// using Tarjan's algorithm. Each node in g appears in exactly
+// one component.
+//
+// The components are returned in reverse topological order: if there
+// is an edge from a node in component i to a node in component j,
+// then j <= i. The order is deterministic given the same
+// sequence of inputs.
+func TarjanSCC[Node comparable, Edge any](g Graph[Node, Edge]) [][]Node {
+	t := &tarjan[Node, Edge]{
+		g:       g,
+		index:   make(map[Node]int),
+		lowlink: make(map[Node]int),
+		onStack: make(map[Node]bool),
+	}
+	for _, n := range g.AllNodes() {
+		if _, ok := t.index[n]; !ok {
+			t.visit(n)
+		}
+	}
+	return t.sccs
+}
+
+type tarjan[Node comparable, Edge any] struct {
+	g       Graph[Node, Edge]
+	index   map[Node]int
+	lowlink map[Node]int
+	onStack map[Node]bool
+	stack   []Node
+	sccs    [][]Node
+}
+
+// visit performs a depth-first search from n, adding
+// a component to t.sccs whenever the root of one is found.
+func (t *tarjan[Node, Edge]) visit(n Node) {
+	t.index[n] = len(t.index)
+	t.lowlink[n] = t.index[n]
+	t.stack = append(t.stack, n)
+	t.onStack[n] = true
+	for _, e := range t.g.Edges(n) {
+		from, to := t.g.Nodes(e)
+		if from != n {
+			continue
+		}
+		if _, ok := t.index[to]; !ok {
+			t.visit(to)
+			t.lowlink[n] = min(t.lowlink[n], t.lowlink[to])
+		} else if t.onStack[to] {
+			t.lowlink[n] = min(t.lowlink[n], t.index[to])
+		}
+	}
+	if t.lowlink[n] != t.index[n] {
+		return
+	}
+	// n is the root of a component: pop it off the stack.
+	var scc []Node
+	for {
+		m := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.onStack[m] = false
+		scc = append(scc, m)
+		if m == n {
+			break
+		}
+	}
+	t.sccs = append(t.sccs, scc)
+}
+
+// Condensation returns the condensation of g: the graph formed by
+// contracting each strongly connected component of g to a single node.
+// Each node in the returned graph is the index of a component in the
+// returned slice of components, as returned by TarjanSCC. There is an
+// edge between two components if there's an edge in g from a node in
+// one to a node in the other. The returned graph is always acyclic.
+func Condensation[Node comparable, Edge any](g Graph[Node, Edge]) (*Simple[int], [][]Node) {
+	sccs := TarjanSCC(g)
+	sccOf := sccIndex(sccs)
+	c := new(Simple[int])
+	for i := range sccs {
+		c.AddNode(i)
+	}
+	added := make(map[[2]int]bool)
+	for i, scc := range sccs {
+		for _, n := range scc {
+			for _, e := range g.Edges(n) {
+				from, to := g.Nodes(e)
+				if from != n {
+					continue
+				}
+				edge := [2]int{i, sccOf[to]}
+				if edge[0] == edge[1] || added[edge] {
+					continue
+				}
+				added[edge] = true
+				c.AddEdge(edge[0], edge[1])
+			}
+		}
+	}
+	return c, sccs
+}
+
+// SCCIndex returns a map from each node in g to the index of its
+// strongly connected component in the slice returned by TarjanSCC.
+// Two nodes have the same index if and only if they are in the
+// same component.
+func SCCIndex[Node comparable, Edge any](g Graph[Node, Edge]) map[Node]int {
+	return sccIndex(TarjanSCC(g))
+}
+
+func sccIndex[Node comparable](sccs [][]Node) map[Node]int {
+	index := make(map[Node]int)
+	for i, scc := range sccs {
+		for _, n := range scc {
+			index[n] = i
+		}
+	}
+	return index
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// multiCycleGraph returns the graph used by TestSortMultiCycles.
+func multiCycleGraph() *Simple[string] {
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("A", "C")
+	g.AddEdge("A", "F")
+	g.AddEdge("C", "D")
+	g.AddEdge("C", "E")
+	g.AddEdge("E", "A")
+	g.AddEdge("E", "F")
+	g.AddEdge("F", "C")
+	return g
+}
+
+func TestTarjanSCC(t *testing.T) {
+	sccs := TarjanSCC(multiCycleGraph().Graph())
+	for _, scc := range sccs {
+		slices.Sort(scc)
+	}
+	want := [][]string{{"B"}, {"D"}, {"A", "C", "E", "F"}}
+	if !reflect.DeepEqual(sccs, want) {
+		t.Errorf("unexpected components; got %v want %v", sccs, want)
+	}
+}
+
+func TestSCCIndex(t *testing.T) {
+	index := SCCIndex(multiCycleGraph().Graph())
+	if len(index) != 6 {
+		t.Errorf("unexpected index size; got %d want 6", len(index))
+	}
+	for _, n := range []string{"C", "E", "F"} {
+		if index[n] != index["A"] {
+			t.Errorf("%s and A are in the same cycle but have different indexes %d and %d", n, index[n], index["A"])
+		}
+	}
+	for _, pair := range [][2]string{{"A", "B"}, {"A", "D"}, {"B", "D"}} {
+		if index[pair[0]] == index[pair[1]] {
+			t.Errorf("%s and %s are in different components but have the same index %d", pair[0], pair[1], index[pair[0]])
+		}
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	c, sccs := Condensation(multiCycleGraph().Graph())
+	index := make(map[string]int)
+	for i, scc := range sccs {
+		for _, n := range scc {
+			index[n] = i
+		}
+	}
+	if len(sccs) != 3 {
+		t.Fatalf("unexpected component count; got %v", sccs)
+	}
+	if got, want := c.AllNodes(), []int{0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected condensation nodes; got %v want %v", got, want)
+	}
+	var edges [][2]int
+	for _, n := range c.AllNodes() {
+		edges = append(edges, c.Edges(n)...)
+	}
+	want := [][2]int{
+		{index["A"], index["B"]},
+		{index["A"], index["D"]},
+	}
+	slices.SortFunc(edges, compareEdges)
+	slices.SortFunc(want, compareEdges)
+	if !reflect.DeepEqual(edges, want) {
+		t.Errorf("unexpected condensation edges; got %v want %v", edges, want)
+	}
+	if _, cycles := TopoSort(c.Graph()); len(cycles) != 0 {
+		t.Errorf("condensation has cycles %v", cycles)
+	}
+}
+
+func compareEdges(e1, e2 [2]int) int {
+	if c := e1[0] - e2[0]; c != 0 {
+		return c
+	}
+	return e1[1] - e2[1]
+}
@@ -16,7 +16,15 @@ type item[Node, Edge any] struct {
 // ShortestPath returns the shortest path from -> to in the graph g
 // using Dijkstra's algorithm. The returned slice holds all the edges
 // leading from the source to the destination.
+//
+// If g implements EdgeLengther, the path with the smallest total
+// length is returned; otherwise the path with the fewest edges is
+// returned.
 func ShortestPath[Node comparable, Edge any](g Graph[Node, Edge], from, to Node) []Edge {
+	length := func(Edge) int { return 1 }
+	if g, ok := g.(EdgeLengther[Edge]); ok {
+		length = g.Length
+	}
 	h := heap.New([]*item[Node, Edge]{{
 		n:     from,
 		dist:  0,
@@ -39,7 +47,7 @@ func ShortestPath[Node comparable, Edge any](g Graph[Node, Edge], from, to Node)
 			if edgeFrom != nearest.n {
 				continue
 			}
-			dist := nearest.dist + 1 // Could use e.Length() instead of 1 if edges had lengths.
+			dist := nearest.dist + length(e)
 			toItem, ok := nodes[edgeTo]
 			if !ok {
 				it := &item[Node, Edge]{
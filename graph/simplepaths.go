@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+// AllSimplePaths returns an iterator over all the simple paths
+// (paths that don't visit any node more than once) from s to t in g.
+// Each path is yielded as the sequence of nodes along it, starting
+// with s and ending with t; the caller may retain the slice.
+// Paths are produced in depth-first order, following edges in the
+// order returned by g.Edges.
+//
+// Note that the number of simple paths can grow exponentially with
+// the size of the graph, so on anything other than small or sparse
+// graphs, callers should be prepared to stop iterating early.
+func AllSimplePaths[Node comparable, Edge any](g Graph[Node, Edge], s, t Node) iter.Seq[[]Node] {
+	return func(yield func([]Node) bool) {
+		sp := &simplePaths[Node, Edge]{
+			g:      g,
+			t:      t,
+			onPath: make(map[Node]bool),
+			yield:  yield,
+		}
+		sp.visit(s)
+	}
+}
+
+type simplePaths[Node comparable, Edge any] struct {
+	g      Graph[Node, Edge]
+	t      Node
+	path   []Node
+	onPath map[Node]bool
+	yield  func([]Node) bool
+}
+
+// visit visits n, yielding all paths to the target that
+// extend the current path through n. It reports
+// whether the iteration should continue.
+func (sp *simplePaths[Node, Edge]) visit(n Node) bool {
+	sp.path = append(sp.path, n)
+	defer func() {
+		sp.path = sp.path[:len(sp.path)-1]
+	}()
+	if n == sp.t {
+		return sp.yield(slices.Clone(sp.path))
+	}
+	sp.onPath[n] = true
+	defer delete(sp.onPath, n)
+	for _, e := range sp.g.Edges(n) {
+		from, to := sp.g.Nodes(e)
+		if from != n || sp.onPath[to] {
+			continue
+		}
+		if !sp.visit(to) {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultLongestPathNodes holds the node limit used by LongestSimplePath.
+const defaultLongestPathNodes = 20
+
+// ErrTooManyNodes is returned by LongestSimplePath and
+// LongestSimplePathLimit when the graph has more nodes
+// than the limit.
+var ErrTooManyNodes = errors.New("graph has too many nodes")
+
+// LongestSimplePath returns the simple path from s to t in g with
+// the most edges, as a sequence of nodes. If there are several such
+// paths, the first one produced by AllSimplePaths is returned. It
+// reports false if there's no path from s to t.
+//
+// Finding the longest simple path is NP-hard, and this function
+// examines every simple path from s to t. To guard against
+// accidental exponential running time, it returns ErrTooManyNodes
+// without searching if g has more than 20 nodes; use
+// LongestSimplePathLimit to change the limit.
+func LongestSimplePath[Node comparable, Edge any](g Graph[Node, Edge], s, t Node) ([]Node, bool, error) {
+	return LongestSimplePathLimit(g, s, t, defaultLongestPathNodes)
+}
+
+// LongestSimplePathLimit is like LongestSimplePath except that
+// it returns ErrTooManyNodes if g has more than maxNodes nodes.
+func LongestSimplePathLimit[Node comparable, Edge any](g Graph[Node, Edge], s, t Node, maxNodes int) ([]Node, bool, error) {
+	if len(g.AllNodes()) > maxNodes {
+		return nil, false, ErrTooManyNodes
+	}
+	var longest []Node
+	for path := range AllSimplePaths(g, s, t) {
+		if len(path) > len(longest) {
+			longest = path
+		}
+	}
+	return longest, longest != nil, nil
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllSimplePaths(t *testing.T) {
+	g := multiCycleGraph()
+	var got [][]string
+	for path := range AllSimplePaths(g.Graph(), "A", "F") {
+		got = append(got, path)
+	}
+	want := [][]string{
+		{"A", "C", "E", "F"},
+		{"A", "F"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected paths; got %v want %v", got, want)
+	}
+}
+
+func TestAllSimplePathsStop(t *testing.T) {
+	// A diamond chain with 2^3 paths from 0 to 6.
+	g := new(Simple[int])
+	for i := 0; i < 6; i += 2 {
+		g.AddEdge(i, i+1)
+		g.AddEdge(i, i+2)
+		g.AddEdge(i+1, i+2)
+	}
+	n := 0
+	for range AllSimplePaths(g.Graph(), 0, 6) {
+		n++
+	}
+	if n != 8 {
+		t.Errorf("unexpected path count; got %d want 8", n)
+	}
+	n = 0
+	for range AllSimplePaths(g.Graph(), 0, 6) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("iteration did not stop; got %d paths", n)
+	}
+}
+
+func TestLongestSimplePath(t *testing.T) {
+	g := multiCycleGraph()
+	path, ok, err := LongestSimplePath(g.Graph(), "A", "D")
+	if err != nil || !ok {
+		t.Fatalf("no path found: %v", err)
+	}
+	// The shortest path is A->C->D.
+	if want := []string{"A", "F", "C", "D"}; !reflect.DeepEqual(path, want) {
+		t.Errorf("unexpected path; got %v want %v", path, want)
+	}
+	if path, ok, err := LongestSimplePath(g.Graph(), "D", "A"); ok || err != nil {
+		t.Errorf("unexpected result from D to A: %v, %v", path, err)
+	}
+	if path, ok, err := LongestSimplePathLimit(g.Graph(), "A", "D", 5); ok || err != ErrTooManyNodes {
+		t.Errorf("unexpected result from graph larger than limit; got %v, %v want %v", path, err, ErrTooManyNodes)
+	}
+}
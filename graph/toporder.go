@@ -0,0 +1,35 @@
+package graph
+
+// IsTopologicalOrder reports whether order is a valid topological
+// ordering of g, using the same convention as TopoSort: order must
+// hold every node in g exactly once, and for every edge, the node
+// the edge points to must appear before the node it comes from.
+func IsTopologicalOrder[Node comparable, Edge any](g Graph[Node, Edge], order []Node) bool {
+	nodes := g.AllNodes()
+	if len(order) != len(nodes) {
+		return false
+	}
+	index := make(map[Node]int, len(order))
+	for i, n := range order {
+		if _, ok := index[n]; ok {
+			return false
+		}
+		index[n] = i
+	}
+	for _, n := range nodes {
+		i, ok := index[n]
+		if !ok {
+			return false
+		}
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n {
+				continue
+			}
+			if index[to] >= i {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package graph
+
+import "testing"
+
+var isTopologicalOrderTests = []struct {
+	testName string
+	order    []string
+	want     bool
+}{{
+	testName: "valid",
+	order:    []string{"B", "D", "F", "E", "C", "A"},
+	want:     true,
+}, {
+	testName: "another-valid",
+	order:    []string{"F", "E", "D", "C", "B", "A"},
+	want:     true,
+}, {
+	testName: "edge-violated",
+	order:    []string{"B", "D", "E", "F", "C", "A"},
+}, {
+	testName: "missing-node",
+	order:    []string{"B", "D", "F", "E", "A"},
+}, {
+	testName: "duplicate-node",
+	order:    []string{"B", "D", "F", "E", "C", "A", "A"},
+}, {
+	testName: "duplicate-replaces-node",
+	order:    []string{"B", "D", "F", "E", "A", "A"},
+}, {
+	testName: "unknown-node",
+	order:    []string{"B", "D", "F", "E", "C", "X"},
+}}
+
+func TestIsTopologicalOrder(t *testing.T) {
+	g := new(Simple[string])
+	g.AddEdge("A", "B")
+	g.AddEdge("A", "C")
+	g.AddEdge("A", "F")
+	g.AddEdge("C", "D")
+	g.AddEdge("C", "E")
+	g.AddEdge("E", "F")
+	for _, test := range isTopologicalOrderTests {
+		t.Run(test.testName, func(t *testing.T) {
+			if got := IsTopologicalOrder(g.Graph(), test.order); got != test.want {
+				t.Errorf("unexpected result; got %v want %v", got, test.want)
+			}
+		})
+	}
+	sorted, _ := TopoSort(g.Graph())
+	if !IsTopologicalOrder(g.Graph(), sorted) {
+		t.Errorf("TopoSort result %v is not a topological order", sorted)
+	}
+}
@@ -0,0 +1,32 @@
+package graph
+
+// neighbors returns the undirected adjacency of g: for each node,
+// the distinct nodes that it's connected to by an edge in either
+// direction, not including itself. Only edges returned by g.Edges(n)
+// with n as their source are considered, so a graph that returns
+// both incoming and outgoing edges from Edges does not count
+// edges twice.
+//
+// Neighbors are listed in the order that they're first encountered
+// when traversing g.AllNodes and g.Edges, so the result is
+// deterministic when g is.
+func neighbors[Node comparable, Edge any](g Graph[Node, Edge]) map[Node][]Node {
+	adj := make(map[Node][]Node)
+	seen := make(map[[2]Node]bool)
+	for _, n := range g.AllNodes() {
+		if _, ok := adj[n]; !ok {
+			adj[n] = nil
+		}
+		for _, e := range g.Edges(n) {
+			from, to := g.Nodes(e)
+			if from != n || from == to || seen[[2]Node{from, to}] {
+				continue
+			}
+			seen[[2]Node{from, to}] = true
+			seen[[2]Node{to, from}] = true
+			adj[from] = append(adj[from], to)
+			adj[to] = append(adj[to], from)
+		}
+	}
+	return adj
+}
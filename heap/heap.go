@@ -16,6 +16,8 @@
 //
 package heap
 
+import "cmp"
+
 // New returns a binary heap on the items slice, using less to compare.
 // If setIndex is non-nil, it will be called when an item in the heap
 // is moved, and passed a pointer to the item that has moved
@@ -30,6 +32,20 @@ func New[E any](items []E, less func(E, E) bool, setIndex func(e *E, i int)) *He
 	return h
 }
 
+// NewOrdered returns a min-heap on the items slice, using cmp.Less
+// to compare. Pop will return the smallest item.
+func NewOrdered[E cmp.Ordered](items []E) *Heap[E] {
+	return New(items, cmp.Less[E], nil)
+}
+
+// NewOrderedMax returns a max-heap on the items slice, using cmp.Less
+// to compare. Pop will return the largest item.
+func NewOrderedMax[E cmp.Ordered](items []E) *Heap[E] {
+	return New(items, func(a, b E) bool {
+		return cmp.Less(b, a)
+	}, nil)
+}
+
 // Heap implements a binary heap.
 type Heap[E any] struct {
 	// Items holds all the items in the heap. The first item is less
@@ -107,6 +123,46 @@ func (h *Heap[E]) Remove(i int) E {
 	return h.pop()
 }
 
+// RemoveFunc removes all the elements for which pred returns true
+// and returns the number of elements removed.
+// The complexity is O(n) where n = h.Len().
+func (h *Heap[E]) RemoveFunc(pred func(E) bool) int {
+	n0 := len(h.Items)
+	i := 0
+	for _, x := range h.Items {
+		if pred(x) {
+			continue
+		}
+		h.Items[i] = x
+		if h.setIndex != nil {
+			h.setIndex(&h.Items[i], i)
+		}
+		i++
+	}
+	clear(h.Items[i:])
+	h.Items = h.Items[:i]
+	h.Init()
+	return n0 - i
+}
+
+// UpdateAll calls mutate on each element in the heap, which may
+// change its value arbitrarily, and then re-establishes the heap
+// ordering. This is more efficient than calling Fix on each
+// element when many elements have changed. If the heap has
+// a setIndex function, it's called for every element afterwards.
+// The complexity is O(n) where n = h.Len().
+func (h *Heap[E]) UpdateAll(mutate func(e *E)) {
+	for i := range h.Items {
+		mutate(&h.Items[i])
+	}
+	h.Init()
+	if h.setIndex != nil {
+		for i := range h.Items {
+			h.setIndex(&h.Items[i], i)
+		}
+	}
+}
+
 func (h *Heap[E]) pop() E {
 	n := len(h.Items) - 1
 	x := h.Items[n]
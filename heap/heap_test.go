@@ -135,3 +135,122 @@ func TestFix(t *testing.T) {
 		verifyHeap(t, h, 0)
 	}
 }
+
+func TestNewOrdered(t *testing.T) {
+	h := NewOrdered([]int{5, 2, 8, 1, 9, 3})
+	h.Push(4)
+	verifyHeap(t, h, 0)
+	want := []int{1, 2, 3, 4, 5, 8, 9}
+	for i := 0; h.Len() > 0; i++ {
+		if x := h.Pop(); x != want[i] {
+			t.Errorf("%d.th pop got %d; want %d", i+1, x, want[i])
+		}
+	}
+}
+
+func TestNewOrderedMax(t *testing.T) {
+	h := NewOrderedMax([]string{"b", "d", "a"})
+	h.Push("c")
+	want := []string{"d", "c", "b", "a"}
+	for i := 0; h.Len() > 0; i++ {
+		if x := h.Pop(); x != want[i] {
+			t.Errorf("%d.th pop got %q; want %q", i+1, x, want[i])
+		}
+	}
+}
+
+func TestRemoveFunc(t *testing.T) {
+	type item struct {
+		x, index int
+	}
+	var items []*item
+	for i := 0; i < 20; i++ {
+		items = append(items, &item{x: (i * 7) % 20})
+	}
+	h := New(items, func(a, b *item) bool {
+		return a.x < b.x
+	}, func(it **item, i int) {
+		(*it).index = i
+	})
+	n := h.RemoveFunc(func(it *item) bool {
+		return it.x%2 == 0
+	})
+	if n != 10 {
+		t.Errorf("RemoveFunc removed %d items; want 10", n)
+	}
+	for i, it := range h.Items {
+		if it.index != i {
+			t.Errorf("item %d has index %d", i, it.index)
+		}
+	}
+	for i := 1; h.Len() > 0; i += 2 {
+		if x := h.Pop().x; x != i {
+			t.Errorf("pop got %d; want %d", x, i)
+		}
+	}
+}
+
+func TestStable(t *testing.T) {
+	type task struct {
+		prio int
+		name string
+	}
+	h := NewStable([]task{{2, "a"}, {1, "b"}, {2, "c"}}, func(t0, t1 task) bool {
+		return t0.prio < t1.prio
+	})
+	h.Push(task{0, "d"})
+	h.Push(task{2, "e"})
+	h.Push(task{1, "f"})
+	h.Push(task{2, "g"})
+	want := []string{"d", "b", "f", "a", "c", "e", "g"}
+	for i := 0; h.Len() > 0; i++ {
+		if x := h.Pop().name; x != want[i] {
+			t.Errorf("%d.th pop got %q; want %q", i+1, x, want[i])
+		}
+	}
+}
+
+func TestUpdateAll(t *testing.T) {
+	h := newIntHeap(nil)
+	for i := 0; i < 20; i++ {
+		h.Push((i * 7) % 20)
+	}
+	// Negating every element reverses the order.
+	h.UpdateAll(func(x *int) {
+		*x *= -1
+	})
+	verifyHeap(t, h, 0)
+	for i := 19; h.Len() > 0; i-- {
+		if x := h.Pop(); x != -i {
+			t.Errorf("pop got %d; want %d", x, -i)
+		}
+	}
+}
+
+func TestUpdateAllSetIndex(t *testing.T) {
+	type item struct {
+		x, index int
+	}
+	var items []*item
+	for i := 0; i < 20; i++ {
+		items = append(items, &item{x: i})
+	}
+	h := New(items, func(a, b *item) bool {
+		return a.x < b.x
+	}, func(it **item, i int) {
+		(*it).index = i
+	})
+	h.UpdateAll(func(it **item) {
+		(*it).x = ((*it).x * 7) % 20
+	})
+	for i, it := range h.Items {
+		if it.index != i {
+			t.Errorf("item %d has index %d", i, it.index)
+		}
+	}
+	for i := 0; h.Len() > 0; i++ {
+		if x := h.Pop().x; x != i {
+			t.Errorf("pop got %d; want %d", x, i)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package heap
+
+// Stable implements a binary heap that is stable: elements
+// that compare equal are popped in the order they were pushed.
+type Stable[E any] struct {
+	heap *Heap[stableItem[E]]
+	seq  uint64
+}
+
+// stableItem holds an element in a Stable heap along with
+// the sequence number used to break ties.
+type stableItem[E any] struct {
+	x   E
+	seq uint64
+}
+
+// NewStable returns a stable binary heap holding the given items,
+// using less to compare. Items that compare equal are popped in
+// the order they appear in the items slice, followed by any
+// equal items subsequently pushed, in the order they were pushed.
+//
+// Unlike New, NewStable does not use items as the heap's
+// backing storage.
+func NewStable[E any](items []E, less func(E, E) bool) *Stable[E] {
+	h := &Stable[E]{}
+	sitems := make([]stableItem[E], len(items))
+	for i, x := range items {
+		sitems[i] = h.newItem(x)
+	}
+	h.heap = New(sitems, func(a, b stableItem[E]) bool {
+		if less(a.x, b.x) {
+			return true
+		}
+		if less(b.x, a.x) {
+			return false
+		}
+		return a.seq < b.seq
+	}, nil)
+	return h
+}
+
+// Len returns the number of items in the heap.
+func (h *Stable[E]) Len() int {
+	return h.heap.Len()
+}
+
+// Push pushes the element x onto the heap.
+// The complexity is O(log n) where n = h.Len().
+func (h *Stable[E]) Push(x E) {
+	h.heap.Push(h.newItem(x))
+}
+
+// Pop removes and returns the minimum element (according to the less function) from the heap.
+// Of several minimum elements, the one pushed first is returned.
+// The complexity is O(log n) where n = h.Len().
+func (h *Stable[E]) Pop() E {
+	return h.heap.Pop().x
+}
+
+func (h *Stable[E]) newItem(x E) stableItem[E] {
+	item := stableItem[E]{x, h.seq}
+	h.seq++
+	return item
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -108,6 +109,67 @@ func (c equalsChecker[T]) Check(got T, note func(key string, value interface{}))
 	return nil
 }
 
+// IsTrue checks that a boolean value is true.
+func IsTrue() Checker[bool] {
+	return Equals(true)
+}
+
+// IsFalse checks that a boolean value is false.
+func IsFalse() Checker[bool] {
+	return Equals(false)
+}
+
+// IsNil checks that a value is nil. Unlike comparing
+// against nil directly, this also succeeds for a nil pointer,
+// slice, map, channel or function held in an interface value.
+func IsNil[T any]() Checker[T] {
+	return nilChecker[T]{
+		argNames: []string{"got"},
+		wantNil:  true,
+	}
+}
+
+// IsNotNil checks that a value is not nil. It is the
+// inverse of IsNil.
+func IsNotNil[T any]() Checker[T] {
+	return nilChecker[T]{
+		argNames: []string{"got"},
+	}
+}
+
+type nilChecker[T any] struct {
+	argNames
+	wantNil bool
+}
+
+func (c nilChecker[T]) Args() []interface{} {
+	return nil
+}
+
+func (c nilChecker[T]) Check(got T, note func(key string, value interface{})) error {
+	if isNil(got) == c.wantNil {
+		return nil
+	}
+	note("got", got)
+	if c.wantNil {
+		return errors.New("value is not nil")
+	}
+	return errors.New("value is nil")
+}
+
+// isNil reports whether x is nil or holds a nil value
+// of a kind that can be nil.
+func isNil(x interface{}) bool {
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return v.IsNil()
+	}
+	return false
+}
+
 //// Contains returns a checker that checks whether
 //// a slice contains the given element.
 //func Contains[T comparable](want T) Checker[[]T] {
@@ -168,13 +230,44 @@ type cmpOption struct {
 //
 //func ErrorMatches(pattern string) Checker[error]
 //
-//func Matches(pattern string) Checker[string]
-//
 //func StringerMatches(pattern string) Checker[interface{ String() string }]
 //
 //
 //func Satisfies[T any](f func(T) bool) Checker[T]
 
+// Matches checks that the argument matches the given regular
+// expression. The match is anchored, so the whole string
+// must match, not just some part of it.
+func Matches(pattern string) Checker[string] {
+	return matchesChecker{
+		argNames: []string{"got string", "regexp"},
+		pattern:  pattern,
+	}
+}
+
+type matchesChecker struct {
+	argNames
+	pattern string
+}
+
+func (c matchesChecker) Args() []interface{} {
+	return []interface{}{c.pattern}
+}
+
+func (c matchesChecker) Check(got string, note func(key string, value interface{})) error {
+	re, err := regexp.Compile("^(?:" + c.pattern + ")$")
+	if err != nil {
+		note("regexp", c.pattern)
+		return fmt.Errorf("bad regexp: %v", err)
+	}
+	if !re.MatchString(got) {
+		note("got", got)
+		note("regexp", c.pattern)
+		return errors.New("value does not match regexp")
+	}
+	return nil
+}
+
 // argNames helps implementing Checker.ArgNames.
 type argNames []string
 
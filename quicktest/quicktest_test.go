@@ -6,3 +6,105 @@ func TestFoo(t *testing.T) {
 	x := 5
 	Assert(t, x, Equals(5))
 }
+
+var matchesTests = []struct {
+	testName  string
+	got       string
+	pattern   string
+	wantErr   string
+	wantNotes []string
+}{{
+	testName: "match",
+	got:      "hello world",
+	pattern:  "hel+o w.*",
+}, {
+	testName:  "no-match",
+	got:       "hello world",
+	pattern:   "goodbye.*",
+	wantErr:   "value does not match regexp",
+	wantNotes: []string{"got", "regexp"},
+}, {
+	testName:  "partial-match",
+	got:       "hello world",
+	pattern:   "world",
+	wantErr:   "value does not match regexp",
+	wantNotes: []string{"got", "regexp"},
+}, {
+	testName:  "alternation-is-anchored",
+	got:       "hello world",
+	pattern:   "hello|world",
+	wantErr:   "value does not match regexp",
+	wantNotes: []string{"got", "regexp"},
+}, {
+	testName:  "bad-pattern",
+	got:       "hello world",
+	pattern:   "hello(",
+	wantErr:   "bad regexp: error parsing regexp: missing closing ): `^(?:hello()$`",
+	wantNotes: []string{"regexp"},
+}}
+
+func TestMatches(t *testing.T) {
+	for _, test := range matchesTests {
+		t.Run(test.testName, func(t *testing.T) {
+			var notes []string
+			noteValues := make(map[string]interface{})
+			err := Matches(test.pattern).Check(test.got, func(key string, value interface{}) {
+				notes = append(notes, key)
+				noteValues[key] = value
+			})
+			Assert(t, notes, DeepEquals(test.wantNotes))
+			if test.wantErr == "" {
+				Assert(t, err, IsZero[error]())
+				return
+			}
+			Assert(t, err, Not(IsZero[error]()))
+			Assert(t, err.Error(), Equals(test.wantErr))
+			if _, ok := noteValues["got"]; ok {
+				Assert(t, noteValues["got"], DeepEquals[interface{}](test.got))
+			}
+			Assert(t, noteValues["regexp"], DeepEquals[interface{}](test.pattern))
+		})
+	}
+}
+
+func TestIsNil(t *testing.T) {
+	var nilPtr *int
+	var nilErr error
+	var typedNilErr error = (*testError)(nil)
+	Assert(t, nilPtr, IsNil[*int]())
+	Assert(t, nilErr, IsNil[error]())
+	Assert(t, typedNilErr, IsNil[error]())
+	Assert(t, any(nilPtr), IsNil[any]())
+	Assert(t, []int(nil), IsNil[[]int]())
+	Assert(t, map[string]int(nil), IsNil[map[string]int]())
+
+	x := 5
+	Assert(t, &x, IsNotNil[*int]())
+	Assert(t, any(x), IsNotNil[any]())
+	Assert(t, []int{}, IsNotNil[[]int]())
+
+	var notes []string
+	err := IsNil[any]().Check(0, func(key string, value interface{}) {
+		notes = append(notes, key)
+	})
+	Assert(t, err, Not(IsNil[error]()))
+	Assert(t, err.Error(), Equals("value is not nil"))
+	Assert(t, notes, DeepEquals([]string{"got"}))
+
+	err = IsNotNil[error]().Check(typedNilErr, func(string, interface{}) {})
+	Assert(t, err, Not(IsNil[error]()))
+	Assert(t, err.Error(), Equals("value is nil"))
+}
+
+type testError struct{}
+
+func (*testError) Error() string {
+	return "test error"
+}
+
+func TestIsTrueIsFalse(t *testing.T) {
+	Assert(t, true, IsTrue())
+	Assert(t, false, IsFalse())
+	Assert(t, false, Not(IsTrue()))
+	Assert(t, true, Not(IsFalse()))
+}
@@ -3,6 +3,8 @@ package ring
 import (
 	"iter"
 	"math/bits"
+
+	"github.com/rogpeppe/generic/genericio"
 )
 
 // Buffer holds a slice-backed ring buffer. Elements
@@ -27,6 +29,11 @@ type Buffer[T any] struct {
 
 	// len holds the number of elements in the buffer.
 	len int
+
+	// bound holds the maximum number of elements
+	// kept by PushEndOverwrite and PushStartOverwrite.
+	// If it's zero, the capacity is used instead.
+	bound int
 }
 
 // NewBuffer returns a buffer with at least the specified capacity.
@@ -36,6 +43,25 @@ func NewBuffer[T any](minCap int) *Buffer[T] {
 	return &b
 }
 
+// NewBoundedBuffer returns a buffer that holds at most n elements when
+// added with PushEndOverwrite or PushStartOverwrite, which discard
+// elements from the other end of the buffer rather than letting it
+// grow beyond that. This makes it possible to use the buffer as a
+// fixed-size sliding window.
+//
+// The other methods are not affected: in particular PushEnd and
+// PushStart still grow the buffer as needed.
+//
+// NewBoundedBuffer panics if n is not positive.
+func NewBoundedBuffer[T any](n int) *Buffer[T] {
+	if n <= 0 {
+		panic("ring.NewBoundedBuffer called with non-positive bound")
+	}
+	b := NewBuffer[T](n)
+	b.bound = n
+	return b
+}
+
 // All returns an iterator over all the values in the buffer.
 func (b *Buffer[T]) All() iter.Seq[T] {
 	return func(yield func(T) bool) {
@@ -48,6 +74,66 @@ func (b *Buffer[T]) All() iter.Seq[T] {
 	}
 }
 
+// Backward returns an iterator over all the values in the buffer
+// in reverse order, from the end to the start.
+func (b *Buffer[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s0, s1 := b.slices()
+		for _, s := range [2][]T{s1, s0} {
+			for i := len(s) - 1; i >= 0; i-- {
+				if !yield(s[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Clone returns a copy of b with its own backing storage,
+// so changes to one do not affect the other. The elements
+// in the clone start at the beginning of its backing storage
+// and its capacity is the smallest power of two that holds
+// them all.
+//
+// The elements are copied as if by assignment, so
+// if T contains pointers, the clone will share the values
+// they point to.
+func (b *Buffer[T]) Clone() *Buffer[T] {
+	var c Buffer[T]
+	c.ensureCap(b.Len())
+	s0, s1 := b.slices()
+	n := copy(c.buf, s0)
+	copy(c.buf[n:], s1)
+	c.len = b.Len()
+	c.i1 = c.mod(c.len)
+	c.bound = b.bound
+	return &c
+}
+
+// Equal reports whether b and other hold the same number of elements
+// and eq reports true for each pair of elements at the same index.
+// The layout of the elements in the buffers' backing storage
+// doesn't matter.
+func (b *Buffer[T]) Equal(other *Buffer[T], eq func(T, T) bool) bool {
+	if b.Len() != other.Len() {
+		return false
+	}
+	for i := range b.Len() {
+		if !eq(b.Get(i), other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualComparable is like Buffer.Equal but uses == to
+// compare elements.
+func EqualComparable[T comparable](b0, b1 *Buffer[T]) bool {
+	return b0.Equal(b1, func(x, y T) bool {
+		return x == y
+	})
+}
+
 // PeekStart returns the element at the start of the buffer
 // without consuming it. It's equivalent to b.Get(0),
 // and panics if the buffer is empty.
@@ -74,6 +160,65 @@ func (b *Buffer[T]) PushEnd(x T) {
 	b.len++
 }
 
+// PushEndOverwrite is like PushEnd except that if the buffer is
+// already at its bound (see NewBoundedBuffer) or, for a buffer
+// not created with NewBoundedBuffer, at its capacity, it first
+// removes the element at the start of the buffer and returns it.
+// If the buffer has no capacity and no bound, it grows as PushEnd does.
+func (b *Buffer[T]) PushEndOverwrite(x T) (evicted T, didEvict bool) {
+	if b.full() {
+		evicted, didEvict = b.PopStart(), true
+	}
+	b.PushEnd(x)
+	return evicted, didEvict
+}
+
+// PushStartOverwrite is like PushEndOverwrite except that it pushes x
+// at the start of the buffer, removing the element at the end if
+// necessary.
+func (b *Buffer[T]) PushStartOverwrite(x T) (evicted T, didEvict bool) {
+	if b.full() {
+		evicted, didEvict = b.PopEnd(), true
+	}
+	b.PushStart(x)
+	return evicted, didEvict
+}
+
+// full reports whether the buffer is at its bound
+// for the purposes of PushEndOverwrite and PushStartOverwrite.
+func (b *Buffer[T]) full() bool {
+	if b.bound > 0 {
+		return b.len >= b.bound
+	}
+	return b.len > 0 && b.len == len(b.buf)
+}
+
+// pushSeqChunk holds the number of elements that PushSeq
+// gathers before pushing them onto the buffer.
+const pushSeqChunk = 64
+
+// PushSeq pushes all the elements produced by seq onto the
+// end of the buffer. The buffer grows as needed, doubling its
+// capacity each time, so the amortized cost per element is constant.
+//
+// Elements are gathered into chunks and pushed with PushSliceEnd,
+// so capacity is checked once per chunk rather than once per element.
+// If the number of elements is known in advance, calling Grow
+// first avoids any intermediate reallocations.
+func (b *Buffer[T]) PushSeq(seq iter.Seq[T]) {
+	var chunk [pushSeqChunk]T
+	n := 0
+	for x := range seq {
+		chunk[n] = x
+		n++
+		if n == len(chunk) {
+			b.PushSliceEnd(chunk[:])
+			n = 0
+		}
+	}
+	b.PushSliceEnd(chunk[:n])
+}
+
 // PushSliceEnd pushes all the elements of the
 // given slice onto the end of the buffer.
 // It's just like:
@@ -85,13 +230,13 @@ func (b *Buffer[T]) PushEnd(x T) {
 // but more efficient.
 func (b *Buffer[T]) PushSliceEnd(src []T) {
 	b.ensureCap(b.Len() + len(src))
-	if b.i1+len(src) <= len(b.buf) {
-		copy(b.buf[b.i1:], src)
-		b.i1 += len(src)
-	} else {
-		n := copy(b.buf[b.i1:], src)
-		copy(b.buf, src[n:])
-	}
+	// We know there's enough room, so anything that
+	// doesn't fit before the end of b.buf must fit at
+	// the start.
+	n := copy(b.buf[b.i1:], src)
+	copy(b.buf, src[n:])
+	b.i1 = b.mod(b.i1 + len(src))
+	b.len += len(src)
 }
 
 // PushSliceStart pushes all the elements of the
@@ -115,6 +260,31 @@ func (b *Buffer[T]) PushSliceStart(src []T) {
 	}
 }
 
+// Write implements genericio.Writer by pushing all the
+// elements of p onto the end of the buffer. It always
+// returns len(p), nil.
+func (b *Buffer[T]) Write(p []T) (int, error) {
+	b.PushSliceEnd(p)
+	return len(p), nil
+}
+
+// Read implements genericio.Reader by removing elements
+// from the start of the buffer and copying them into p.
+// It returns genericio.EOF if the buffer is empty.
+func (b *Buffer[T]) Read(p []T) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if b.Len() == 0 {
+		return 0, genericio.EOF
+	}
+	s0, s1 := b.slices()
+	n := copy(p, s0)
+	n += copy(p[n:], s1)
+	b.DiscardFromStart(n)
+	return n, nil
+}
+
 // DiscardFromStart discards min(b.Len(), n) elements from
 // the start of the buffer and returns the number actually
 // discarded
@@ -157,6 +327,17 @@ func (b *Buffer[T]) DiscardFromEnd(n int) int {
 	return n
 }
 
+// Clear removes all the elements from the buffer, zeroing the
+// slots they occupied so that any values they refer to can be
+// garbage collected. It does not change the capacity.
+func (b *Buffer[T]) Clear() {
+	s0, s1 := b.slices()
+	clear(s0)
+	clear(s1)
+	b.i0, b.i1 = 0, 0
+	b.len = 0
+}
+
 // Copy copies min(b.Len(), len(dst)) values into dst
 // from index i in the buffer onwards. It does not affect
 // the size of the buffer. It returns the number of elements
@@ -170,19 +351,22 @@ func (b *Buffer[T]) Copy(dst []T, i int) int {
 		return 0
 	}
 	dst = dst[:n]
-	if b.i0+i <= len(b.buf) {
-		// Easy case: it's all in contiguous elements.
-		copy(dst, b.buf[b.mod(b.i0+i):])
-	} else {
-		nc := copy(dst, b.buf[b.i0+i:min(len(b.buf), n)])
-		if nc == n {
-			return n
-		}
-		copy(dst[nc:], b.buf[:b.mod(b.i0+i+nc)])
-	}
+	// Copy up to the end of the backing slice, then
+	// any remaining elements from its start.
+	nc := copy(dst, b.buf[b.mod(b.i0+i):])
+	copy(dst[nc:], b.buf)
 	return n
 }
 
+// ToSlice returns a newly allocated slice holding all
+// the elements in the buffer in order. It returns a
+// non-nil empty slice when the buffer is empty.
+func (b *Buffer[T]) ToSlice() []T {
+	s := make([]T, b.Len())
+	b.Copy(s, 0)
+	return s
+}
+
 // PeekEnd returns the element at the end of the buffer
 // without consuming it. It's equivalent to b.Get(b.Len()-1).
 func (b *Buffer[T]) PeekEnd() T {
@@ -212,6 +396,17 @@ func (b *Buffer[T]) SetCap(n int) {
 	b.resize(n)
 }
 
+// Grow grows the buffer's capacity, if necessary, to guarantee space
+// for another n elements. After Grow(n), at least n elements can be
+// pushed onto the buffer without another allocation. It does not
+// change the buffer's contents. It panics if n is negative.
+func (b *Buffer[T]) Grow(n int) {
+	if n < 0 {
+		panic("ring.Buffer.Grow called with negative count")
+	}
+	b.ensureCap(b.Len() + n)
+}
+
 // Get returns the i'th element in the buffer; the start element
 // is at index zero; the end is at b.Len() - 1.
 // It panics if i is out of range.
@@ -222,6 +417,137 @@ func (b *Buffer[T]) Get(i int) T {
 	return b.buf[b.mod(b.i0+i)]
 }
 
+// Set sets the i'th element in the buffer to x.
+// It panics if i is out of range.
+func (b *Buffer[T]) Set(i int, x T) {
+	if i < 0 || i >= b.Len() {
+		panic("ring.Buffer.Set called with index out of range")
+	}
+	b.buf[b.mod(b.i0+i)] = x
+}
+
+// Insert inserts x at index i, moving the elements previously
+// at i and after it up one place. It moves whichever of the
+// elements before and after i are fewer, and grows the buffer if
+// needed. It panics if i is out of range; i may be b.Len(), in
+// which case it's equivalent to b.PushEnd(x).
+func (b *Buffer[T]) Insert(i int, x T) {
+	if i < 0 || i > b.Len() {
+		panic("ring.Buffer.Insert called with index out of range")
+	}
+	b.ensureCap(b.len + 1)
+	if i < b.len/2 {
+		// Move the elements before i down one place.
+		b.i0 = b.mod(b.i0 + len(b.buf) - 1)
+		for k := 0; k < i; k++ {
+			b.buf[b.mod(b.i0+k)] = b.buf[b.mod(b.i0+k+1)]
+		}
+	} else {
+		// Move the elements from i onwards up one place.
+		for k := b.len; k > i; k-- {
+			b.buf[b.mod(b.i0+k)] = b.buf[b.mod(b.i0+k-1)]
+		}
+		b.i1 = b.mod(b.i1 + 1)
+	}
+	b.buf[b.mod(b.i0+i)] = x
+	b.len++
+}
+
+// RemoveAt removes and returns the element at index i, moving
+// the elements after it down one place. It moves whichever of
+// the elements before and after i are fewer. It panics if i is
+// out of range.
+func (b *Buffer[T]) RemoveAt(i int) T {
+	if i < 0 || i >= b.Len() {
+		panic("ring.Buffer.RemoveAt called with index out of range")
+	}
+	x := b.buf[b.mod(b.i0+i)]
+	if i < b.len/2 {
+		// Move the elements before i up one place.
+		for k := i; k > 0; k-- {
+			b.buf[b.mod(b.i0+k)] = b.buf[b.mod(b.i0+k-1)]
+		}
+		b.buf[b.i0] = *new(T)
+		b.i0 = b.mod(b.i0 + 1)
+	} else {
+		// Move the elements after i down one place.
+		for k := i; k < b.len-1; k++ {
+			b.buf[b.mod(b.i0+k)] = b.buf[b.mod(b.i0+k+1)]
+		}
+		b.i1 = b.mod(b.i1 + len(b.buf) - 1)
+		b.buf[b.i1] = *new(T)
+	}
+	b.len--
+	return x
+}
+
+// Swap exchanges the i'th and j'th elements in the buffer.
+// It panics if either index is out of range.
+func (b *Buffer[T]) Swap(i, j int) {
+	if i < 0 || i >= b.Len() || j < 0 || j >= b.Len() {
+		panic("ring.Buffer.Swap called with index out of range")
+	}
+	i, j = b.mod(b.i0+i), b.mod(b.i0+j)
+	b.buf[i], b.buf[j] = b.buf[j], b.buf[i]
+}
+
+// Reverse reverses the order of the elements in the buffer.
+func (b *Buffer[T]) Reverse() {
+	for i, j := 0, b.Len()-1; i < j; i, j = i+1, j-1 {
+		b.Swap(i, j)
+	}
+}
+
+// RotateLeft rotates the elements of the buffer n places to the left,
+// so the element previously at index n is now at index 0 and
+// the elements previously before it are now at the end.
+// If n is negative, it rotates to the right instead.
+//
+// When the buffer is full (b.Len() == b.Cap()), this is O(1).
+// Otherwise it moves min(n, b.Len()-n) elements, where n is
+// taken modulo b.Len().
+func (b *Buffer[T]) RotateLeft(n int) {
+	if b.len == 0 {
+		return
+	}
+	n %= b.len
+	if n < 0 {
+		n += b.len
+	}
+	if n == 0 {
+		return
+	}
+	if b.len == len(b.buf) {
+		// The buffer is full, so moving the start
+		// moves the end with it.
+		b.i0 = b.mod(b.i0 + n)
+		b.i1 = b.i0
+		return
+	}
+	if n <= b.len/2 {
+		for range n {
+			b.buf[b.i1] = b.buf[b.i0]
+			b.buf[b.i0] = *new(T)
+			b.i0 = b.mod(b.i0 + 1)
+			b.i1 = b.mod(b.i1 + 1)
+		}
+		return
+	}
+	for range b.len - n {
+		b.i0 = b.mod(b.i0 + len(b.buf) - 1)
+		b.i1 = b.mod(b.i1 + len(b.buf) - 1)
+		b.buf[b.i0] = b.buf[b.i1]
+		b.buf[b.i1] = *new(T)
+	}
+}
+
+// RotateRight rotates the elements of the buffer n places to the right,
+// so the element previously at the end is now at index n-1.
+// It's equivalent to b.RotateLeft(-n).
+func (b *Buffer[T]) RotateRight(n int) {
+	b.RotateLeft(-n)
+}
+
 // PopStart removes and returns the element from the start of the buffer. If the
 // buffer is empty, the call will panic.
 func (b *Buffer[T]) PopStart() T {
@@ -241,9 +567,9 @@ func (b *Buffer[T]) PopEnd() T {
 	if b.Len() <= 0 {
 		panic("ring.Buffer.PopEnd called on empty buffer")
 	}
-	x := b.buf[b.mod(b.i1-1)]
-	b.buf[b.i1] = *new(T)
 	b.i1 = b.mod(b.i1 + len(b.buf) - 1)
+	x := b.buf[b.i1]
+	b.buf[b.i1] = *new(T)
 	b.len--
 	return x
 }
@@ -273,6 +599,19 @@ func (b *Buffer[T]) resize(minCap int) {
 	b.buf = buf1
 }
 
+// slices returns the elements in the buffer as
+// two slices of the backing array; the elements
+// in s0 come before the elements in s1.
+func (b *Buffer[T]) slices() (s0, s1 []T) {
+	switch {
+	case b.len == 0:
+		return nil, nil
+	case b.i0 < b.i1:
+		return b.buf[b.i0:b.i1], nil
+	}
+	return b.buf[b.i0:], b.buf[:b.i1]
+}
+
 // mod returns x modulo the buffer capacity.
 // It relies on the fact that the buffer capacity is
 // always a power of 2.
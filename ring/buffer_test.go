@@ -2,8 +2,10 @@ package ring_test
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 
+	"github.com/rogpeppe/generic/genericio"
 	"github.com/rogpeppe/generic/ring"
 )
 
@@ -212,6 +214,48 @@ func TestCopy(t *testing.T) {
 	})
 }
 
+func TestCopyWrapped(t *testing.T) {
+	b := ring.NewBuffer[int](8)
+	for i := range 6 {
+		b.PushEnd(i)
+	}
+	b.DiscardFromStart(4)
+	for i := 6; i < 10; i++ {
+		b.PushEnd(i)
+	}
+	// b is now [4 5 6 7 8 9], wrapped around the end of its backing slice.
+	for i := range b.Len() {
+		dst := make([]int, 10)
+		n := b.Copy(dst, i)
+		if want := []int{4, 5, 6, 7, 8, 9}[i:]; !reflect.DeepEqual(dst[:n], want) {
+			t.Errorf("Copy from %d got %v want %v", i, dst[:n], want)
+		}
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	b := ring.NewBuffer[int](8)
+	for i := range 6 {
+		b.PushEnd(i)
+	}
+	b.DiscardFromStart(4)
+	for i := 6; i < 10; i++ {
+		b.PushEnd(i)
+	}
+	s := b.ToSlice()
+	if want := []int{4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(s, want) {
+		t.Errorf("unexpected slice; got %v want %v", s, want)
+	}
+	s[0] = 100
+	if got := b.Get(0); got != 4 {
+		t.Errorf("slice aliases buffer; got %d want 4", got)
+	}
+	var empty ring.Buffer[int]
+	if s := empty.ToSlice(); s == nil || len(s) != 0 {
+		t.Errorf("unexpected slice from empty buffer: %#v", s)
+	}
+}
+
 func TestDiscardBeyondLength(t *testing.T) {
 	b := ring.NewBuffer[int](5)
 	b.PushEnd(1)
@@ -302,6 +346,522 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestSet(t *testing.T) {
+	b := ring.NewBuffer[int](4)
+	for i := range 4 {
+		b.PushEnd(i)
+	}
+	b.PopStart()
+	b.PopStart()
+	b.PushEnd(4)
+	b.PushEnd(5)
+	// b is now [2 3 4 5], wrapped around the end of its backing slice.
+	for i := range b.Len() {
+		b.Set(i, b.Get(i)*10)
+	}
+	if got, want := contents(b), []int{20, 30, 40, 50}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	mustPanic(t, func() { b.Set(-1, 0) })
+	mustPanic(t, func() { b.Set(4, 0) })
+}
+
+func TestPushSliceEnd(t *testing.T) {
+	b := ring.NewBuffer[int](8)
+	for i := range 6 {
+		b.PushEnd(i)
+	}
+	b.DiscardFromStart(5)
+	// The pushed slice wraps around the end of the backing slice.
+	b.PushSliceEnd([]int{6, 7, 8, 9})
+	if got, want := contents(b), []int{5, 6, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	// The buffer needs to grow to fit this one.
+	b.PushSliceEnd([]int{10, 11, 12, 13})
+	if got, want := contents(b), []int{5, 6, 7, 8, 9, 10, 11, 12, 13}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	b.PushEnd(14)
+	if got, want := b.PeekEnd(), 14; got != want {
+		t.Errorf("unexpected end element; got %v want %v", got, want)
+	}
+}
+
+func TestPushSeq(t *testing.T) {
+	b := ring.NewBuffer[int](4)
+	b.PushEnd(-1)
+	xs := make([]int, 100)
+	for i := range xs {
+		xs[i] = i
+	}
+	b.PushSeq(slices.Values(xs))
+	if got, want := b.Len(), 101; got != want {
+		t.Errorf("unexpected length; got %d want %d", got, want)
+	}
+	if got, want := contents(b), append([]int{-1}, xs...); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+
+	// Push onto a buffer that wraps, with enough capacity
+	// that it doesn't need to grow.
+	b = ring.NewBuffer[int](256)
+	for i := range 200 {
+		b.PushEnd(i + 1000)
+	}
+	b.DiscardFromStart(150)
+	b.Grow(len(xs))
+	capacity := b.Cap()
+	want := append(contents(b), xs...)
+	b.PushSeq(slices.Values(xs))
+	if got := b.Cap(); got != capacity {
+		t.Errorf("capacity changed after Grow; got %d want %d", got, capacity)
+	}
+	if got := contents(b); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	var _ genericio.ReadWriter[int] = (*ring.Buffer[int])(nil)
+
+	b := ring.NewBuffer[int](4)
+	if n, err := b.Write([]int{1, 2, 3}); n != 3 || err != nil {
+		t.Fatalf("Write = %v, %v; want 3, nil", n, err)
+	}
+	buf := make([]int, 2)
+	if n, err := b.Read(buf); n != 2 || err != nil || !reflect.DeepEqual(buf, []int{1, 2}) {
+		t.Fatalf("Read = %v, %v, %v; want 2, nil, [1 2]", n, err, buf)
+	}
+	// This write wraps around the end of the backing slice,
+	// so the next read must read from both parts.
+	b.Write([]int{4, 5, 6})
+	buf = make([]int, 10)
+	if n, err := b.Read(buf); n != 4 || err != nil || !reflect.DeepEqual(buf[:n], []int{3, 4, 5, 6}) {
+		t.Fatalf("Read = %v, %v, %v; want 4, nil, [3 4 5 6]", n, err, buf[:n])
+	}
+	if n, err := b.Read(buf); n != 0 || err != genericio.EOF {
+		t.Fatalf("Read = %v, %v; want 0, EOF", n, err)
+	}
+
+	// Copy between buffers.
+	src := ring.NewBuffer[int](0)
+	for i := range 100 {
+		src.PushEnd(i)
+	}
+	dst := ring.NewBuffer[int](0)
+	n, err := genericio.Copy[int](dst, src)
+	if n != 100 || err != nil {
+		t.Fatalf("Copy = %v, %v; want 100, nil", n, err)
+	}
+	if src.Len() != 0 || dst.Len() != 100 {
+		t.Fatalf("unexpected lengths after copy; src %d dst %d", src.Len(), dst.Len())
+	}
+	for i := range 100 {
+		if got := dst.Get(i); got != i {
+			t.Fatalf("unexpected element at %d; got %d", i, got)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	// Test with buffers that are full and not full, and
+	// that do and do not wrap around the end of their
+	// backing slice.
+	for _, size := range []int{5, 8} {
+		for _, offset := range []int{0, 6} {
+			for n := -10; n <= 10; n++ {
+				b := ring.NewBuffer[int](8)
+				for range offset {
+					b.PushEnd(-1)
+				}
+				b.DiscardFromStart(offset)
+				xs := make([]int, size)
+				for i := range xs {
+					xs[i] = i
+					b.PushEnd(i)
+				}
+				b.RotateLeft(n)
+				k := ((n % size) + size) % size
+				want := append(slices.Clone(xs[k:]), xs[:k]...)
+				if got := contents(b); !reflect.DeepEqual(got, want) {
+					t.Errorf("size %d, offset %d: RotateLeft(%d) got %v want %v", size, offset, n, got, want)
+				}
+				b.RotateRight(n)
+				if got := contents(b); !reflect.DeepEqual(got, xs) {
+					t.Errorf("size %d, offset %d: RotateRight(%d) got %v want %v", size, offset, n, got, xs)
+				}
+				b.PushStart(-1)
+				b.PushEnd(size)
+				if got, want := contents(b), append(append([]int{-1}, xs...), size); !reflect.DeepEqual(got, want) {
+					t.Errorf("size %d, offset %d: push after rotate got %v want %v", size, offset, got, want)
+				}
+			}
+		}
+	}
+	var b ring.Buffer[int]
+	b.RotateLeft(3)
+	b.RotateRight(3)
+	if b.Len() != 0 {
+		t.Errorf("unexpected length after rotating empty buffer: %d", b.Len())
+	}
+}
+
+func TestBackward(t *testing.T) {
+	b := ring.NewBuffer[int](8)
+	for i := range 6 {
+		b.PushEnd(i)
+	}
+	b.DiscardFromStart(4)
+	for i := 6; i < 10; i++ {
+		b.PushEnd(i)
+	}
+	// b is now [4 5 6 7 8 9], wrapped around the end of its backing slice.
+	var got []int
+	for x := range b.Backward() {
+		got = append(got, x)
+	}
+	if want := []int{9, 8, 7, 6, 5, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected elements; got %v want %v", got, want)
+	}
+
+	got = nil
+	for x := range b.Backward() {
+		if x == 7 {
+			break
+		}
+		got = append(got, x)
+	}
+	if want := []int{9, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected elements after break; got %v want %v", got, want)
+	}
+
+	var empty ring.Buffer[int]
+	for x := range empty.Backward() {
+		t.Errorf("unexpected element %v in empty buffer", x)
+	}
+}
+
+func TestBoundedBuffer(t *testing.T) {
+	b := ring.NewBoundedBuffer[int](3)
+	var evicted []int
+	for i := range 6 {
+		if x, ok := b.PushEndOverwrite(i); ok {
+			evicted = append(evicted, x)
+		}
+	}
+	if got, want := contents(b), []int{3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(evicted, want) {
+		t.Errorf("unexpected evicted elements; got %v want %v", evicted, want)
+	}
+	if x, ok := b.PushStartOverwrite(2); x != 5 || !ok {
+		t.Errorf("PushStartOverwrite = %v, %v; want 5, true", x, ok)
+	}
+	if got, want := contents(b), []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	// The bound is preserved by Clone.
+	c := b.Clone()
+	c.PushEndOverwrite(5)
+	if got, want := contents(c), []int{3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected clone contents; got %v want %v", got, want)
+	}
+	// PushEnd still grows the buffer.
+	b.PushEnd(5)
+	if got, want := contents(b), []int{2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	mustPanic(t, func() { ring.NewBoundedBuffer[int](0) })
+}
+
+func TestPushOverwriteAtCapacity(t *testing.T) {
+	// A buffer not created with NewBoundedBuffer
+	// is bounded by its capacity.
+	b := ring.NewBuffer[int](4)
+	for i := range 4 {
+		if _, ok := b.PushStartOverwrite(i); ok {
+			t.Errorf("unexpected eviction when pushing %d", i)
+		}
+	}
+	if x, ok := b.PushStartOverwrite(4); x != 0 || !ok {
+		t.Errorf("PushStartOverwrite = %v, %v; want 0, true", x, ok)
+	}
+	if got, want := contents(b), []int{4, 3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	if got, want := b.Cap(), 4; got != want {
+		t.Errorf("unexpected capacity; got %d want %d", got, want)
+	}
+
+	var zero ring.Buffer[int]
+	if _, ok := zero.PushEndOverwrite(1); ok {
+		t.Errorf("unexpected eviction from zero buffer")
+	}
+	if got, want := contents(&zero), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+}
+
+func TestInsertRemoveAt(t *testing.T) {
+	// Test with buffers that do and do not wrap around
+	// the end of their backing slice, at every index.
+	for _, offset := range []int{0, 5} {
+		for i := 0; i <= 6; i++ {
+			b := ring.NewBuffer[int](8)
+			for range offset {
+				b.PushEnd(-1)
+			}
+			b.DiscardFromStart(offset)
+			xs := []int{0, 1, 2, 3, 4, 5}
+			for _, x := range xs {
+				b.PushEnd(x)
+			}
+			b.Insert(i, 100)
+			want := slices.Insert(slices.Clone(xs), i, 100)
+			if got := contents(b); !reflect.DeepEqual(got, want) {
+				t.Errorf("offset %d: Insert(%d) got %v want %v", offset, i, got, want)
+			}
+			if got := b.RemoveAt(i); got != 100 {
+				t.Errorf("offset %d: RemoveAt(%d) got %v want 100", offset, i, got)
+			}
+			if got := contents(b); !reflect.DeepEqual(got, xs) {
+				t.Errorf("offset %d: after RemoveAt(%d) got %v want %v", offset, i, got, xs)
+			}
+			if i == len(xs) {
+				continue
+			}
+			if got := b.RemoveAt(i); got != xs[i] {
+				t.Errorf("offset %d: RemoveAt(%d) got %v want %v", offset, i, got, xs[i])
+			}
+			want = slices.Delete(slices.Clone(xs), i, i+1)
+			if got := contents(b); !reflect.DeepEqual(got, want) {
+				t.Errorf("offset %d: after second RemoveAt(%d) got %v want %v", offset, i, got, want)
+			}
+			// Check that the start and end are still consistent.
+			b.PushStart(-1)
+			b.PushEnd(6)
+			want = append(append([]int{-1}, want...), 6)
+			if got := contents(b); !reflect.DeepEqual(got, want) {
+				t.Errorf("offset %d: after push got %v want %v", offset, got, want)
+			}
+		}
+	}
+}
+
+func TestInsertGrow(t *testing.T) {
+	b := ring.NewBuffer[int](4)
+	for i := range 4 {
+		b.PushEnd(i)
+	}
+	b.Insert(2, 100)
+	if got, want := contents(b), []int{0, 1, 100, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	var empty ring.Buffer[int]
+	empty.Insert(0, 1)
+	if got, want := contents(&empty), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	mustPanic(t, func() { b.Insert(-1, 0) })
+	mustPanic(t, func() { b.Insert(6, 0) })
+	mustPanic(t, func() { b.RemoveAt(-1) })
+	mustPanic(t, func() { b.RemoveAt(5) })
+}
+
+func TestGrow(t *testing.T) {
+	b := ring.NewBuffer[int](4)
+	for i := range 3 {
+		b.PushEnd(i)
+	}
+	b.Grow(0)
+	if got, want := b.Cap(), 4; got != want {
+		t.Errorf("unexpected capacity after Grow(0); got %d want %d", got, want)
+	}
+	b.Grow(1)
+	if got, want := b.Cap(), 4; got != want {
+		t.Errorf("unexpected capacity after Grow(1); got %d want %d", got, want)
+	}
+	b.Grow(10)
+	if got, want := b.Cap(), 16; got != want {
+		t.Errorf("unexpected capacity after Grow(10); got %d want %d", got, want)
+	}
+	if got, want := contents(b), []int{0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+	mustPanic(t, func() { b.Grow(-1) })
+}
+
+func TestReverse(t *testing.T) {
+	// Contiguous layout.
+	b := ring.NewBuffer[int](8)
+	for i := range 5 {
+		b.PushEnd(i)
+	}
+	b.Reverse()
+	if got, want := contents(b), []int{4, 3, 2, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after Reverse, got %v; want %v", got, want)
+	}
+
+	// Wrapped layout.
+	b = ring.NewBuffer[int](4)
+	b.PushEnd(0)
+	b.PushEnd(1)
+	b.PushEnd(2)
+	b.PopStart()
+	b.PopStart()
+	b.PushEnd(3)
+	b.PushEnd(4)
+	b.PushEnd(5) // now [2,3,4,5] wrapping around the end of the backing slice.
+	b.Reverse()
+	if got, want := contents(b), []int{5, 4, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after Reverse, got %v; want %v", got, want)
+	}
+
+	// Empty buffer.
+	var empty ring.Buffer[int]
+	empty.Reverse()
+	if empty.Len() != 0 {
+		t.Errorf("Reverse of empty buffer has length %d", empty.Len())
+	}
+}
+
+func TestSwap(t *testing.T) {
+	b := ring.NewBuffer[string](4)
+	b.PushEnd("A")
+	b.PushEnd("B")
+	b.PushStart("Z") // now [Z,A,B] with Z at the end of the backing slice.
+	b.Swap(0, 2)
+	if got, want := contents(b), []string{"B", "A", "Z"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after Swap, got %v; want %v", got, want)
+	}
+	mustPanic(t, func() { b.Swap(0, 3) })
+	mustPanic(t, func() { b.Swap(-1, 0) })
+}
+
+func TestClone(t *testing.T) {
+	b := ring.NewBuffer[int](8)
+	for i := range 6 {
+		b.PushEnd(i)
+	}
+	b.DiscardFromStart(4)
+	for i := 6; i < 10; i++ {
+		b.PushEnd(i)
+	}
+	// b is now [4 5 6 7 8 9], wrapped around the end of its backing slice.
+	c := b.Clone()
+	if got, want := contents(c), []int{4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected clone contents; got %v want %v", got, want)
+	}
+	if got, want := c.Cap(), 8; got != want {
+		t.Errorf("unexpected clone capacity; got %d want %d", got, want)
+	}
+	c.PopStart()
+	c.PushEnd(100)
+	b.PushStart(-1)
+	if got, want := contents(b), []int{-1, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("original affected by changes to clone; got %v want %v", got, want)
+	}
+	if got, want := contents(c), []int{5, 6, 7, 8, 9, 100}; !reflect.DeepEqual(got, want) {
+		t.Errorf("clone affected by changes to original; got %v want %v", got, want)
+	}
+
+	// Cloning an empty buffer.
+	var empty ring.Buffer[int]
+	c = empty.Clone()
+	if c.Len() != 0 || c.Cap() != 0 {
+		t.Errorf("unexpected clone of empty buffer; len %d cap %d", c.Len(), c.Cap())
+	}
+	c.PushEnd(1)
+	if got, want := contents(c), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected contents; got %v want %v", got, want)
+	}
+}
+
+func TestClear(t *testing.T) {
+	b := ring.NewBuffer[*int](8)
+	for i := range 6 {
+		b.PushEnd(&i)
+	}
+	b.DiscardFromStart(4)
+	for i := range 4 {
+		b.PushEnd(&i)
+	}
+	// b now wraps around the end of its backing slice.
+	b.Clear()
+	if b.Len() != 0 {
+		t.Errorf("unexpected length after Clear; got %d want 0", b.Len())
+	}
+	if got, want := b.Cap(), 8; got != want {
+		t.Errorf("unexpected capacity after Clear; got %d want %d", got, want)
+	}
+	for i := range 8 {
+		b.PushEnd(&i)
+	}
+	if got, want := b.Cap(), 8; got != want {
+		t.Errorf("unexpected capacity after refilling; got %d want %d", got, want)
+	}
+	for i := range 8 {
+		if got := *b.Get(i); got != i {
+			t.Errorf("unexpected element at %d; got %d", i, got)
+		}
+	}
+
+	// Clearing empty and zero-value buffers is a no-op.
+	b.Clear()
+	b.Clear()
+	var zero ring.Buffer[int]
+	zero.Clear()
+	if zero.Len() != 0 || zero.Cap() != 0 {
+		t.Errorf("unexpected zero buffer after Clear; len %d cap %d", zero.Len(), zero.Cap())
+	}
+}
+
+func TestEqual(t *testing.T) {
+	b0 := ring.NewBuffer[int](8)
+	b1 := ring.NewBuffer[int](16)
+	for i := range 6 {
+		b0.PushEnd(i)
+	}
+	b0.DiscardFromStart(4)
+	for i := 4; i < 6; i++ {
+		b1.PushEnd(i)
+	}
+	for i := 6; i < 12; i++ {
+		b0.PushEnd(i)
+		b1.PushEnd(i)
+	}
+	// b0 wraps around the end of its backing slice but b1 doesn't.
+	if !ring.EqualComparable(b0, b1) {
+		t.Errorf("buffers with the same elements are not equal; %v vs %v", contents(b0), contents(b1))
+	}
+	b1.Set(3, 100)
+	if ring.EqualComparable(b0, b1) {
+		t.Errorf("buffers with different elements are equal")
+	}
+	if !b0.Equal(b1, func(x, y int) bool { return x == y || y == 100 }) {
+		t.Errorf("buffers not equal with custom equality function")
+	}
+	b1.Set(3, b0.Get(3))
+	b1.PushEnd(12)
+	if ring.EqualComparable(b0, b1) {
+		t.Errorf("buffers with different lengths are equal")
+	}
+	if !ring.EqualComparable(new(ring.Buffer[int]), ring.NewBuffer[int](4)) {
+		t.Errorf("empty buffers are not equal")
+	}
+}
+
+// contents returns all the elements of b in order.
+func contents[T any](b *ring.Buffer[T]) []T {
+	var xs []T
+	for x := range b.All() {
+		xs = append(xs, x)
+	}
+	return xs
+}
+
 func mustPanic(t *testing.T, f func()) {
 	t.Helper()
 	defer func() {
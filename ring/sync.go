@@ -0,0 +1,101 @@
+package ring
+
+import (
+	"context"
+	"sync"
+)
+
+// SyncBuffer wraps a Buffer so that it can be used concurrently
+// from multiple goroutines. It can be used as a concurrent FIFO
+// queue by pushing at the end and popping from the start.
+//
+// The zero value is OK to use.
+type SyncBuffer[T any] struct {
+	mu sync.Mutex
+	// nonEmpty is signalled when an element is pushed.
+	// It's initialized lazily.
+	nonEmpty sync.Cond
+	buf      Buffer[T]
+}
+
+// init initializes b.nonEmpty. It's called with b.mu held.
+func (b *SyncBuffer[T]) init() {
+	if b.nonEmpty.L == nil {
+		b.nonEmpty.L = &b.mu
+	}
+}
+
+// Len returns the number of elements in the buffer.
+func (b *SyncBuffer[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Len()
+}
+
+// PushStart pushes an element to the start of the buffer.
+func (b *SyncBuffer[T]) PushStart(x T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.init()
+	b.buf.PushStart(x)
+	b.nonEmpty.Signal()
+}
+
+// PushEnd adds an element to the end of the buffer.
+func (b *SyncBuffer[T]) PushEnd(x T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.init()
+	b.buf.PushEnd(x)
+	b.nonEmpty.Signal()
+}
+
+// PopStart removes and returns the element from the start of the
+// buffer and reports whether there was one. Unlike Buffer.PopStart,
+// it does not panic when the buffer is empty, because another
+// goroutine might have emptied the buffer after a call to Len.
+func (b *SyncBuffer[T]) PopStart() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.buf.Len() == 0 {
+		return *new(T), false
+	}
+	return b.buf.PopStart(), true
+}
+
+// PopEnd removes and returns the element from the end of the
+// buffer and reports whether there was one.
+func (b *SyncBuffer[T]) PopEnd() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.buf.Len() == 0 {
+		return *new(T), false
+	}
+	return b.buf.PopEnd(), true
+}
+
+// WaitPopStart is like PopStart except that it waits for an
+// element to become available if the buffer is empty. It returns
+// the context's error if the context is done before then.
+func (b *SyncBuffer[T]) WaitPopStart(ctx context.Context) (T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.init()
+
+	stop := context.AfterFunc(ctx, func() {
+		// Acquiring the lock guarantees that the waiter below
+		// is either waiting or hasn't yet checked the context,
+		// so the broadcast can't be missed.
+		b.mu.Lock()
+		b.mu.Unlock()
+		b.nonEmpty.Broadcast()
+	})
+	defer stop()
+	for b.buf.Len() == 0 {
+		if err := ctx.Err(); err != nil {
+			return *new(T), err
+		}
+		b.nonEmpty.Wait()
+	}
+	return b.buf.PopStart(), nil
+}
@@ -0,0 +1,85 @@
+package ring_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rogpeppe/generic/ring"
+)
+
+func TestSyncBuffer(t *testing.T) {
+	const (
+		numProducers = 4
+		numConsumers = 3
+		perProducer  = 1000
+	)
+	var b ring.SyncBuffer[int]
+	var producers sync.WaitGroup
+	for p := range numProducers {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := range perProducer {
+				b.PushEnd(p*perProducer + i)
+			}
+		}()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		consumers sync.WaitGroup
+		mu        sync.Mutex
+		got       []int
+	)
+	for range numConsumers {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				x, err := b.WaitPopStart(ctx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				got = append(got, x)
+				done := len(got) == numProducers*perProducer
+				mu.Unlock()
+				if done {
+					cancel()
+				}
+			}
+		}()
+	}
+	producers.Wait()
+	consumers.Wait()
+	slices.Sort(got)
+	for i, x := range got {
+		if x != i {
+			t.Fatalf("unexpected element at %d; got %d", i, x)
+		}
+	}
+	if len(got) != numProducers*perProducer {
+		t.Fatalf("unexpected element count; got %d want %d", len(got), numProducers*perProducer)
+	}
+	if b.Len() != 0 {
+		t.Errorf("unexpected length after consuming everything; got %d", b.Len())
+	}
+}
+
+func TestSyncBufferWaitPopStartCancel(t *testing.T) {
+	var b ring.SyncBuffer[int]
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := b.WaitPopStart(ctx); err != context.Canceled {
+		t.Errorf("unexpected error; got %v want %v", err, context.Canceled)
+	}
+	if _, ok := b.PopStart(); ok {
+		t.Errorf("PopStart unexpectedly succeeded on empty buffer")
+	}
+}
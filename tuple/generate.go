@@ -72,6 +72,12 @@ func generateTupleCode() {
 	P("// Code generated by tuple/generate.go. DO NOT EDIT.\n")
 	P("")
 	P("package tuple\n")
+	P(`
+import (
+	"fmt"
+	"reflect"
+)
+`)
 	for i := 0; i < N; i++ {
 		generateTuple(i)
 		P("\n")
@@ -161,6 +167,30 @@ func generateTuple(n int) {
 	)
 	P("\treturn T%d[%s]{%s}\n", n, commaSep("A", n), commaSep("a", n))
 	P("}\n")
+	P("\n")
+	P("// MkT%dFromSlice returns a %d-tuple formed from the elements of s.\n", n, n)
+	P("// It returns an error if s does not hold exactly %d elements\n", n)
+	P("// or if any element does not have the corresponding tuple type.\n")
+	P("// A nil element is accepted for an interface type and leaves\n")
+	P("// the corresponding field as nil.\n")
+	P("func MkT%dFromSlice[%s any](s []any) (T%d[%s], error) {\n",
+		n,
+		commaSep("A", n),
+		n,
+		commaSep("A", n),
+	)
+	P("\tvar t T%d[%s]\n", n, commaSep("A", n))
+	P("\tif len(s) != %d {\n", n)
+	P("\t\treturn t, fmt.Errorf(\"tuple: slice has %%d elements; want %d\", len(s))\n", n)
+	P("\t}\n")
+	P("\tvar ok bool\n")
+	for i := 0; i < n; i++ {
+		P("\tif t.A%d, ok = s[%d].(A%d); !ok && (s[%d] != nil || reflect.TypeFor[A%d]().Kind() != reflect.Interface) {\n", i, i, i, i, i)
+		P("\t\treturn T%d[%s]{}, fmt.Errorf(\"tuple: element %d has type %%T; want %%v\", s[%d], reflect.TypeFor[A%d]())\n", n, commaSep("A", n), i, i, i)
+		P("\t}\n")
+	}
+	P("\treturn t, nil\n")
+	P("}\n")
 }
 
 func generateToARFunc(a, r int) {
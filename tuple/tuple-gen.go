@@ -1,6 +1,11 @@
 // Code generated by tuple/generate.go. DO NOT EDIT.
 package tuple
 
+import (
+	"fmt"
+	"reflect"
+)
+
 // T0 holds a tuple of 0 values.
 type T0 = struct{}
 
@@ -22,6 +27,26 @@ func MkT2[A0, A1 any](a0 A0, a1 A1) T2[A0, A1] {
 	return T2[A0, A1]{a0, a1}
 }
 
+// MkT2FromSlice returns a 2-tuple formed from the elements of s.
+// It returns an error if s does not hold exactly 2 elements
+// or if any element does not have the corresponding tuple type.
+// A nil element is accepted for an interface type and leaves
+// the corresponding field as nil.
+func MkT2FromSlice[A0, A1 any](s []any) (T2[A0, A1], error) {
+	var t T2[A0, A1]
+	if len(s) != 2 {
+		return t, fmt.Errorf("tuple: slice has %d elements; want 2", len(s))
+	}
+	var ok bool
+	if t.A0, ok = s[0].(A0); !ok && (s[0] != nil || reflect.TypeFor[A0]().Kind() != reflect.Interface) {
+		return T2[A0, A1]{}, fmt.Errorf("tuple: element 0 has type %T; want %v", s[0], reflect.TypeFor[A0]())
+	}
+	if t.A1, ok = s[1].(A1); !ok && (s[1] != nil || reflect.TypeFor[A1]().Kind() != reflect.Interface) {
+		return T2[A0, A1]{}, fmt.Errorf("tuple: element 1 has type %T; want %v", s[1], reflect.TypeFor[A1]())
+	}
+	return t, nil
+}
+
 // T3 holds a tuple of 3 values.
 type T3[A0, A1, A2 any] struct {
 	A0 A0
@@ -39,6 +64,29 @@ func MkT3[A0, A1, A2 any](a0 A0, a1 A1, a2 A2) T3[A0, A1, A2] {
 	return T3[A0, A1, A2]{a0, a1, a2}
 }
 
+// MkT3FromSlice returns a 3-tuple formed from the elements of s.
+// It returns an error if s does not hold exactly 3 elements
+// or if any element does not have the corresponding tuple type.
+// A nil element is accepted for an interface type and leaves
+// the corresponding field as nil.
+func MkT3FromSlice[A0, A1, A2 any](s []any) (T3[A0, A1, A2], error) {
+	var t T3[A0, A1, A2]
+	if len(s) != 3 {
+		return t, fmt.Errorf("tuple: slice has %d elements; want 3", len(s))
+	}
+	var ok bool
+	if t.A0, ok = s[0].(A0); !ok && (s[0] != nil || reflect.TypeFor[A0]().Kind() != reflect.Interface) {
+		return T3[A0, A1, A2]{}, fmt.Errorf("tuple: element 0 has type %T; want %v", s[0], reflect.TypeFor[A0]())
+	}
+	if t.A1, ok = s[1].(A1); !ok && (s[1] != nil || reflect.TypeFor[A1]().Kind() != reflect.Interface) {
+		return T3[A0, A1, A2]{}, fmt.Errorf("tuple: element 1 has type %T; want %v", s[1], reflect.TypeFor[A1]())
+	}
+	if t.A2, ok = s[2].(A2); !ok && (s[2] != nil || reflect.TypeFor[A2]().Kind() != reflect.Interface) {
+		return T3[A0, A1, A2]{}, fmt.Errorf("tuple: element 2 has type %T; want %v", s[2], reflect.TypeFor[A2]())
+	}
+	return t, nil
+}
+
 // T4 holds a tuple of 4 values.
 type T4[A0, A1, A2, A3 any] struct {
 	A0 A0
@@ -57,6 +105,32 @@ func MkT4[A0, A1, A2, A3 any](a0 A0, a1 A1, a2 A2, a3 A3) T4[A0, A1, A2, A3] {
 	return T4[A0, A1, A2, A3]{a0, a1, a2, a3}
 }
 
+// MkT4FromSlice returns a 4-tuple formed from the elements of s.
+// It returns an error if s does not hold exactly 4 elements
+// or if any element does not have the corresponding tuple type.
+// A nil element is accepted for an interface type and leaves
+// the corresponding field as nil.
+func MkT4FromSlice[A0, A1, A2, A3 any](s []any) (T4[A0, A1, A2, A3], error) {
+	var t T4[A0, A1, A2, A3]
+	if len(s) != 4 {
+		return t, fmt.Errorf("tuple: slice has %d elements; want 4", len(s))
+	}
+	var ok bool
+	if t.A0, ok = s[0].(A0); !ok && (s[0] != nil || reflect.TypeFor[A0]().Kind() != reflect.Interface) {
+		return T4[A0, A1, A2, A3]{}, fmt.Errorf("tuple: element 0 has type %T; want %v", s[0], reflect.TypeFor[A0]())
+	}
+	if t.A1, ok = s[1].(A1); !ok && (s[1] != nil || reflect.TypeFor[A1]().Kind() != reflect.Interface) {
+		return T4[A0, A1, A2, A3]{}, fmt.Errorf("tuple: element 1 has type %T; want %v", s[1], reflect.TypeFor[A1]())
+	}
+	if t.A2, ok = s[2].(A2); !ok && (s[2] != nil || reflect.TypeFor[A2]().Kind() != reflect.Interface) {
+		return T4[A0, A1, A2, A3]{}, fmt.Errorf("tuple: element 2 has type %T; want %v", s[2], reflect.TypeFor[A2]())
+	}
+	if t.A3, ok = s[3].(A3); !ok && (s[3] != nil || reflect.TypeFor[A3]().Kind() != reflect.Interface) {
+		return T4[A0, A1, A2, A3]{}, fmt.Errorf("tuple: element 3 has type %T; want %v", s[3], reflect.TypeFor[A3]())
+	}
+	return t, nil
+}
+
 // T5 holds a tuple of 5 values.
 type T5[A0, A1, A2, A3, A4 any] struct {
 	A0 A0
@@ -76,6 +150,35 @@ func MkT5[A0, A1, A2, A3, A4 any](a0 A0, a1 A1, a2 A2, a3 A3, a4 A4) T5[A0, A1,
 	return T5[A0, A1, A2, A3, A4]{a0, a1, a2, a3, a4}
 }
 
+// MkT5FromSlice returns a 5-tuple formed from the elements of s.
+// It returns an error if s does not hold exactly 5 elements
+// or if any element does not have the corresponding tuple type.
+// A nil element is accepted for an interface type and leaves
+// the corresponding field as nil.
+func MkT5FromSlice[A0, A1, A2, A3, A4 any](s []any) (T5[A0, A1, A2, A3, A4], error) {
+	var t T5[A0, A1, A2, A3, A4]
+	if len(s) != 5 {
+		return t, fmt.Errorf("tuple: slice has %d elements; want 5", len(s))
+	}
+	var ok bool
+	if t.A0, ok = s[0].(A0); !ok && (s[0] != nil || reflect.TypeFor[A0]().Kind() != reflect.Interface) {
+		return T5[A0, A1, A2, A3, A4]{}, fmt.Errorf("tuple: element 0 has type %T; want %v", s[0], reflect.TypeFor[A0]())
+	}
+	if t.A1, ok = s[1].(A1); !ok && (s[1] != nil || reflect.TypeFor[A1]().Kind() != reflect.Interface) {
+		return T5[A0, A1, A2, A3, A4]{}, fmt.Errorf("tuple: element 1 has type %T; want %v", s[1], reflect.TypeFor[A1]())
+	}
+	if t.A2, ok = s[2].(A2); !ok && (s[2] != nil || reflect.TypeFor[A2]().Kind() != reflect.Interface) {
+		return T5[A0, A1, A2, A3, A4]{}, fmt.Errorf("tuple: element 2 has type %T; want %v", s[2], reflect.TypeFor[A2]())
+	}
+	if t.A3, ok = s[3].(A3); !ok && (s[3] != nil || reflect.TypeFor[A3]().Kind() != reflect.Interface) {
+		return T5[A0, A1, A2, A3, A4]{}, fmt.Errorf("tuple: element 3 has type %T; want %v", s[3], reflect.TypeFor[A3]())
+	}
+	if t.A4, ok = s[4].(A4); !ok && (s[4] != nil || reflect.TypeFor[A4]().Kind() != reflect.Interface) {
+		return T5[A0, A1, A2, A3, A4]{}, fmt.Errorf("tuple: element 4 has type %T; want %v", s[4], reflect.TypeFor[A4]())
+	}
+	return t, nil
+}
+
 // T6 holds a tuple of 6 values.
 type T6[A0, A1, A2, A3, A4, A5 any] struct {
 	A0 A0
@@ -95,3 +198,35 @@ func (t T6[A0, A1, A2, A3, A4, A5]) T() (A0, A1, A2, A3, A4, A5) {
 func MkT6[A0, A1, A2, A3, A4, A5 any](a0 A0, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) T6[A0, A1, A2, A3, A4, A5] {
 	return T6[A0, A1, A2, A3, A4, A5]{a0, a1, a2, a3, a4, a5}
 }
+
+// MkT6FromSlice returns a 6-tuple formed from the elements of s.
+// It returns an error if s does not hold exactly 6 elements
+// or if any element does not have the corresponding tuple type.
+// A nil element is accepted for an interface type and leaves
+// the corresponding field as nil.
+func MkT6FromSlice[A0, A1, A2, A3, A4, A5 any](s []any) (T6[A0, A1, A2, A3, A4, A5], error) {
+	var t T6[A0, A1, A2, A3, A4, A5]
+	if len(s) != 6 {
+		return t, fmt.Errorf("tuple: slice has %d elements; want 6", len(s))
+	}
+	var ok bool
+	if t.A0, ok = s[0].(A0); !ok && (s[0] != nil || reflect.TypeFor[A0]().Kind() != reflect.Interface) {
+		return T6[A0, A1, A2, A3, A4, A5]{}, fmt.Errorf("tuple: element 0 has type %T; want %v", s[0], reflect.TypeFor[A0]())
+	}
+	if t.A1, ok = s[1].(A1); !ok && (s[1] != nil || reflect.TypeFor[A1]().Kind() != reflect.Interface) {
+		return T6[A0, A1, A2, A3, A4, A5]{}, fmt.Errorf("tuple: element 1 has type %T; want %v", s[1], reflect.TypeFor[A1]())
+	}
+	if t.A2, ok = s[2].(A2); !ok && (s[2] != nil || reflect.TypeFor[A2]().Kind() != reflect.Interface) {
+		return T6[A0, A1, A2, A3, A4, A5]{}, fmt.Errorf("tuple: element 2 has type %T; want %v", s[2], reflect.TypeFor[A2]())
+	}
+	if t.A3, ok = s[3].(A3); !ok && (s[3] != nil || reflect.TypeFor[A3]().Kind() != reflect.Interface) {
+		return T6[A0, A1, A2, A3, A4, A5]{}, fmt.Errorf("tuple: element 3 has type %T; want %v", s[3], reflect.TypeFor[A3]())
+	}
+	if t.A4, ok = s[4].(A4); !ok && (s[4] != nil || reflect.TypeFor[A4]().Kind() != reflect.Interface) {
+		return T6[A0, A1, A2, A3, A4, A5]{}, fmt.Errorf("tuple: element 4 has type %T; want %v", s[4], reflect.TypeFor[A4]())
+	}
+	if t.A5, ok = s[5].(A5); !ok && (s[5] != nil || reflect.TypeFor[A5]().Kind() != reflect.Interface) {
+		return T6[A0, A1, A2, A3, A4, A5]{}, fmt.Errorf("tuple: element 5 has type %T; want %v", s[5], reflect.TypeFor[A5]())
+	}
+	return t, nil
+}
@@ -0,0 +1,50 @@
+package tuple_test
+
+import (
+	"testing"
+
+	"github.com/rogpeppe/generic/tuple"
+)
+
+func TestMkTFromSlice(t *testing.T) {
+	t2, err := tuple.MkT2FromSlice[int, string]([]any{1, "one"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := tuple.MkT2(1, "one"); t2 != want {
+		t.Errorf("unexpected result; got %#v want %#v", t2, want)
+	}
+	// A nil element fills interface-typed fields.
+	t6, err := tuple.MkT6FromSlice[int, string, bool, float64, error, any]([]any{1, "a", true, 1.5, nil, nil})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := tuple.MkT6[int, string, bool, float64, error, any](1, "a", true, 1.5, nil, nil); t6 != want {
+		t.Errorf("unexpected result; got %#v want %#v", t6, want)
+	}
+	// ... but not other types.
+	t2, err = tuple.MkT2FromSlice[int, string]([]any{1, nil})
+	if err == nil {
+		t.Fatalf("expected error from nil string element; got %#v", t2)
+	}
+	if got, want := err.Error(), "tuple: element 1 has type <nil>; want string"; got != want {
+		t.Errorf("unexpected error; got %q want %q", got, want)
+	}
+	t3, err := tuple.MkT3FromSlice[int, string, bool]([]any{1, "a"})
+	if err == nil {
+		t.Fatalf("expected error from short slice; got %#v", t3)
+	}
+	if got, want := err.Error(), "tuple: slice has 2 elements; want 3"; got != want {
+		t.Errorf("unexpected error; got %q want %q", got, want)
+	}
+	t3, err = tuple.MkT3FromSlice[int, string, bool]([]any{1, 2, true})
+	if err == nil {
+		t.Fatalf("expected error from wrongly typed element; got %#v", t3)
+	}
+	if got, want := err.Error(), "tuple: element 1 has type int; want string"; got != want {
+		t.Errorf("unexpected error; got %q want %q", got, want)
+	}
+	if t3 != (tuple.T3[int, string, bool]{}) {
+		t.Errorf("expected zero tuple on error; got %#v", t3)
+	}
+}
@@ -0,0 +1,22 @@
+package tuplefunc
+
+// Compose returns a function that calls f and then
+// calls g on its result.
+func Compose[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// Pipe returns a function that calls each of the functions
+// in fs in turn, from left to right, passing the result
+// of each one to the next. If fs is empty, the returned
+// function returns its argument unchanged.
+func Pipe[T any](fs ...func(T) T) func(T) T {
+	return func(x T) T {
+		for _, f := range fs {
+			x = f(x)
+		}
+		return x
+	}
+}
@@ -0,0 +1,35 @@
+package tuplefunc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rogpeppe/generic/tuple"
+	"github.com/rogpeppe/generic/tuple/tuplefunc"
+)
+
+func TestCompose(t *testing.T) {
+	add := tuplefunc.ToAR_2_1(func(a, b int) int {
+		return a + b
+	})
+	format := func(x int) string {
+		return fmt.Sprintf("result: %d", x)
+	}
+	f := tuplefunc.Compose(add, format)
+	if got, want := f(tuple.MkT2(3, 4)), "result: 7"; got != want {
+		t.Errorf("unexpected result; got %q want %q", got, want)
+	}
+}
+
+func TestPipe(t *testing.T) {
+	f := tuplefunc.Pipe(strings.TrimSpace, strings.ToUpper, func(s string) string {
+		return s + "!"
+	})
+	if got, want := f("  hello "), "HELLO!"; got != want {
+		t.Errorf("unexpected result; got %q want %q", got, want)
+	}
+	if got, want := tuplefunc.Pipe[int]()(5), 5; got != want {
+		t.Errorf("unexpected result from empty pipe; got %d want %d", got, want)
+	}
+}
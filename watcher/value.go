@@ -1,6 +1,15 @@
 package watcher
 
-import "sync"
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by GetContext when the Value
+// has been closed.
+var ErrClosed = errors.New("watcher: value closed")
 
 // Value represents a shared value that can be watched for changes. Methods on
 // a Value may be called concurrently.
@@ -11,13 +20,19 @@ import "sync"
 // By default, watchers will be notified whenever Set is called,
 // but WithUpdater can be used to trigger notifications less often.
 type Value[T any] struct {
-	wait   sync.Cond
-	update UpdateFunc[T]
+	wait sync.Cond
 	// mu guards the fields below it.
 	mu      sync.RWMutex
+	update  UpdateFunc[T]
 	val     T
 	version int
 	closed  bool
+	// set records whether Set has ever been called.
+	set bool
+	// waiters holds channels registered by WaitAny,
+	// which are notified whenever the Value or
+	// one of its watchers changes.
+	waiters map[chan struct{}]bool
 }
 
 // NewValue creates a new Value holding the given initial value.
@@ -37,6 +52,55 @@ func WithUpdater[T any](updater UpdateFunc[T]) *Value[T] {
 	}
 }
 
+// SetUpdater sets the function used to update the Value and report
+// whether it's changed. It affects only subsequent calls to Set and
+// Watcher.Next; previous updates are not reconsidered.
+//
+// If u is nil, Always is used.
+func (v *Value[T]) SetUpdater(u UpdateFunc[T]) {
+	if u == nil {
+		u = Always[T]
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.init()
+	v.update = u
+}
+
+// Poll returns a Value that holds the result of calling sample
+// every interval, starting immediately. When ctx is done, sampling
+// stops and the Value is closed. It panics if interval is not positive.
+//
+// Watchers are notified on every sample; use PollWithUpdater
+// to notify them less often.
+func Poll[T any](ctx context.Context, interval time.Duration, sample func() T) *Value[T] {
+	return PollWithUpdater(ctx, interval, Always[T], sample)
+}
+
+// PollWithUpdater is like Poll except that the returned Value uses
+// the given updater, as with WithUpdater. For example, passing
+// IfUnequal avoids waking watchers when the sample hasn't changed.
+func PollWithUpdater[T any](ctx context.Context, interval time.Duration, updater UpdateFunc[T], sample func() T) *Value[T] {
+	if interval <= 0 {
+		panic("watcher.PollWithUpdater called with non-positive interval")
+	}
+	v := WithUpdater(updater)
+	go func() {
+		defer v.Close()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			v.Set(sample())
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return v
+}
+
 func (v *Value[T]) needsInit() bool {
 	return v.wait.L == nil
 }
@@ -50,6 +114,18 @@ func (v *Value[T]) init() {
 	}
 }
 
+// initRLocked is like init but is called with v.mu held for reading.
+// It may temporarily release the lock.
+func (v *Value[T]) initRLocked() {
+	if v.needsInit() {
+		v.mu.RUnlock()
+		v.mu.Lock()
+		v.init()
+		v.mu.Unlock()
+		v.mu.RLock()
+	}
+}
+
 // Set sets the shared value to val.
 func (v *Value[T]) Set(val T) {
 	v.mu.Lock()
@@ -57,6 +133,8 @@ func (v *Value[T]) Set(val T) {
 	if v.update(&v.val, val) {
 		v.version++
 	}
+	v.set = true
+	v.notifyLocked()
 	v.mu.Unlock()
 	v.wait.Broadcast()
 }
@@ -68,11 +146,23 @@ func (v *Value[T]) Close() error {
 	v.init()
 	v.closed = true
 	v.val = *new(T)
+	v.notifyLocked()
 	v.mu.Unlock()
 	v.wait.Broadcast()
 	return nil
 }
 
+// notifyLocked notifies any WaitAny calls waiting on v.
+// It's called with v.mu held for writing.
+func (v *Value[T]) notifyLocked() {
+	for c := range v.waiters {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
 // Closed reports whether the value has been closed.
 func (v *Value[T]) Closed() bool {
 	v.mu.RLock()
@@ -88,6 +178,40 @@ func (v *Value[T]) Get() T {
 	return v.val
 }
 
+// GetContext returns the current value, waiting until Set has been
+// called at least once. It returns ErrClosed if the Value is closed
+// and the context's error if the context is done before then.
+//
+// Unlike Get, this makes it possible to distinguish a Value that has
+// never been set from one that has been set to the zero value.
+func (v *Value[T]) GetContext(ctx context.Context) (T, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	v.initRLocked()
+
+	stop := context.AfterFunc(ctx, func() {
+		// Acquiring the lock guarantees that the waiter below
+		// is either waiting or hasn't yet checked the context,
+		// so the broadcast can't be missed.
+		v.mu.Lock()
+		v.mu.Unlock()
+		v.wait.Broadcast()
+	})
+	defer stop()
+	for {
+		if v.closed {
+			return *new(T), ErrClosed
+		}
+		if v.set {
+			return v.val, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return *new(T), err
+		}
+		v.wait.Wait()
+	}
+}
+
 // GetOK returns the most recently set value and reports whether
 // it is valid. After v has been closed, GetOK will always return
 // *new(T), false.
@@ -118,13 +242,7 @@ func (w *Watcher[T]) Next() bool {
 	val := w.value
 	val.mu.RLock()
 	defer val.mu.RUnlock()
-	if val.needsInit() {
-		val.mu.RUnlock()
-		val.mu.Lock()
-		val.init()
-		val.mu.Unlock()
-		val.mu.RLock()
-	}
+	val.initRLocked()
 
 	// We can go around this loop a maximum of two times,
 	// because the only thing that can cause a Wait to
@@ -134,13 +252,11 @@ func (w *Watcher[T]) Next() bool {
 	// causing the closed flag to be set.
 	// Both these cases will cause Next to return.
 	for {
-		if w.version != val.version {
-			if val.update(&w.current, val.val) {
-				w.version = val.version
-				return true
-			}
+		changed, closed := w.pollRLocked()
+		if changed {
+			return true
 		}
-		if val.closed || w.closed {
+		if closed {
 			return false
 		}
 
@@ -149,6 +265,84 @@ func (w *Watcher[T]) Next() bool {
 	}
 }
 
+// poll is like Next except that it doesn't block.
+// It returns the Watcher's current value, read while
+// holding the lock, and reports whether there was a new value
+// and whether the Watcher or its value have been closed.
+func (w *Watcher[T]) poll() (current T, changed, closed bool) {
+	val := w.value
+	val.mu.RLock()
+	defer val.mu.RUnlock()
+	val.initRLocked()
+	changed, closed = w.pollRLocked()
+	return w.current, changed, closed
+}
+
+// pollRLocked implements poll. It's called with
+// w.value.mu held for reading.
+func (w *Watcher[T]) pollRLocked() (changed, closed bool) {
+	val := w.value
+	if w.version != val.version && val.update(&w.current, val.val) {
+		w.version = val.version
+		return true, false
+	}
+	return false, val.closed || w.closed
+}
+
+// WaitAny blocks until any of the given watchers has a new value
+// and returns its index in watchers and the new value, which is
+// also available from its Value method; the other watchers are
+// left unchanged. If several watchers have new values, the first
+// one is chosen. Closed watchers are ignored; when all of them have
+// been closed (or there are none), WaitAny returns -1, *new(T), false.
+//
+// As with Next, a Watcher should not be used concurrently
+// by more than one goroutine.
+func WaitAny[T any](watchers ...*Watcher[T]) (index int, value T, ok bool) {
+	c := make(chan struct{}, 1)
+	for _, w := range watchers {
+		w.value.addWaiter(c)
+		defer w.value.removeWaiter(c)
+	}
+	for {
+		allClosed := true
+		for i, w := range watchers {
+			// Use the value returned by poll rather than reading
+			// w.current, which may be changed concurrently by Close
+			// once the lock is released.
+			current, changed, closed := w.poll()
+			if changed {
+				return i, current, true
+			}
+			if !closed {
+				allClosed = false
+			}
+		}
+		if allClosed {
+			return -1, *new(T), false
+		}
+		// Any change since we polled will have
+		// sent a notification on c.
+		<-c
+	}
+}
+
+func (v *Value[T]) addWaiter(c chan struct{}) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.init()
+	if v.waiters == nil {
+		v.waiters = make(map[chan struct{}]bool)
+	}
+	v.waiters[c] = true
+}
+
+func (v *Value[T]) removeWaiter(c chan struct{}) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.waiters, c)
+}
+
 // Close closes the Watcher without closing the underlying
 // value. It may be called concurrently with Next.
 func (w *Watcher[T]) Close() {
@@ -156,6 +350,7 @@ func (w *Watcher[T]) Close() {
 	w.value.init()
 	w.closed = true
 	w.current = *new(T)
+	w.value.notifyLocked()
 	w.value.mu.Unlock()
 	w.value.wait.Broadcast()
 }
@@ -187,3 +382,18 @@ func IfUnequal[T comparable](old *T, new T) bool {
 	*old = new
 	return true
 }
+
+// Transform returns an updater that stores fn(new) into *old
+// and reports a change only if the transformed value
+// differs from *old. This can be used, for example,
+// to store a normalized form of each value.
+//
+// Watchers use the same updater to copy the value
+// into their own state, so fn will be applied again to
+// values that it has already transformed; it should
+// be idempotent.
+func Transform[T comparable](fn func(T) T) UpdateFunc[T] {
+	return func(old *T, new T) bool {
+		return IfUnequal(old, fn(new))
+	}
+}
@@ -1,7 +1,10 @@
 package watcher
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"testing"
@@ -241,3 +244,173 @@ func TestUpdateIfUnequal(t *testing.T) {
 	}
 	c.Assert(got, qt.DeepEquals, []string{"first", "second"})
 }
+
+func TestSetUpdater(t *testing.T) {
+	c := qt.New(t)
+	var v Value[string]
+	w := v.Watch()
+	// Each Set is followed by a call to Next so that the
+	// watcher sees every update.
+	var got []string
+	set := func(s string) {
+		v.Set(s)
+		if w.Next() {
+			got = append(got, w.Value())
+		}
+	}
+	set("a")
+	set("a")
+	v.SetUpdater(IfUnequal[string])
+	v.Set("a")
+	set("b")
+	v.Set("b")
+	v.Close()
+	for w.Next() {
+		got = append(got, w.Value())
+	}
+	c.Assert(got, qt.DeepEquals, []string{"a", "a", "b"})
+}
+
+func TestPoll(t *testing.T) {
+	c := qt.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count atomic.Int64
+	v := Poll(ctx, time.Millisecond, func() int64 {
+		return count.Add(1)
+	})
+	w := v.Watch()
+	prev := int64(0)
+	for i := 0; i < 5; i++ {
+		c.Assert(w.Next(), qt.IsTrue)
+		c.Assert(w.Value() > prev, qt.IsTrue, qt.Commentf("got %d after %d", w.Value(), prev))
+		prev = w.Value()
+	}
+	cancel()
+	for w.Next() {
+	}
+	c.Assert(v.Closed(), qt.IsTrue)
+}
+
+func TestPollNonPositiveInterval(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(func() {
+		Poll(context.Background(), 0, func() int { return 0 })
+	}, qt.PanicMatches, "watcher.PollWithUpdater called with non-positive interval")
+}
+
+func TestPollWithUpdater(t *testing.T) {
+	c := qt.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count atomic.Int64
+	// The sample only changes every 5 calls, and the
+	// watcher should only be woken for the changes.
+	v := PollWithUpdater(ctx, time.Millisecond, IfUnequal[int64], func() int64 {
+		return count.Add(1)/5 + 1
+	})
+	w := v.Watch()
+	prev := int64(0)
+	for i := 0; i < 3; i++ {
+		c.Assert(w.Next(), qt.IsTrue)
+		c.Assert(w.Value() > prev, qt.IsTrue, qt.Commentf("got %d after %d", w.Value(), prev))
+		prev = w.Value()
+	}
+}
+
+func TestWaitAny(t *testing.T) {
+	c := qt.New(t)
+	var v0, v1 Value[string]
+	w0, w1 := v0.Watch(), v1.Watch()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		v1.Set("one")
+	}()
+	i, val, ok := WaitAny(w0, w1)
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(i, qt.Equals, 1)
+	c.Assert(val, qt.Equals, "one")
+
+	// A value that's already available is returned immediately.
+	v0.Set("zero")
+	i, val, ok = WaitAny(w0, w1)
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(i, qt.Equals, 0)
+	c.Assert(val, qt.Equals, "zero")
+
+	// Closed watchers are ignored until they're all closed.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		v0.Close()
+		time.Sleep(10 * time.Millisecond)
+		w1.Close()
+	}()
+	i, val, ok = WaitAny(w0, w1)
+	c.Assert(ok, qt.IsFalse)
+	c.Assert(i, qt.Equals, -1)
+	c.Assert(val, qt.Equals, "")
+	c.Assert(v0.waiters, qt.HasLen, 0)
+	c.Assert(v1.waiters, qt.HasLen, 0)
+}
+
+func TestTransform(t *testing.T) {
+	c := qt.New(t)
+	v := WithUpdater(Transform(strings.TrimSpace))
+	go func() {
+		v.Set(" first ")
+		time.Sleep(time.Millisecond)
+		v.Set("first  ")
+		time.Sleep(time.Millisecond)
+		v.Set("\tsecond")
+		time.Sleep(time.Millisecond)
+		v.Close()
+	}()
+	var got []string
+	for w := v.Watch(); w.Next(); {
+		got = append(got, w.Value())
+	}
+	c.Assert(got, qt.DeepEquals, []string{"first", "second"})
+}
+
+func TestGetContext(t *testing.T) {
+	c := qt.New(t)
+	v := WithUpdater[string](IfUnequal[string])
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		// Setting the zero value counts as a set even though
+		// the updater does not report a change.
+		v.Set("")
+	}()
+	got, err := v.GetContext(context.Background())
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.Equals, "")
+
+	// Once set, GetContext returns immediately.
+	v.Set("hello")
+	got, err = v.GetContext(context.Background())
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.Equals, "hello")
+}
+
+func TestGetContextCancel(t *testing.T) {
+	c := qt.New(t)
+	var v Value[string]
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err := v.GetContext(ctx)
+	c.Assert(err, qt.Equals, context.Canceled)
+}
+
+func TestGetContextClosed(t *testing.T) {
+	c := qt.New(t)
+	var v Value[string]
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		v.Close()
+	}()
+	_, err := v.GetContext(context.Background())
+	c.Assert(err, qt.Equals, ErrClosed)
+}